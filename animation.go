@@ -0,0 +1,149 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/jroimartin/gocui"
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// time each frame of the animations is shown for
+const (
+	REVEAL_FRAME = 120 * time.Millisecond
+	SHAKE_FRAME  = 50 * time.Millisecond
+	BOUNCE_FRAME = 90 * time.Millisecond
+)
+
+// columns the row being typed in is moved by on each frame of a shake
+var shakeOffsets = []int{1, -1, 1, -1, 1, -1}
+
+type animationKind int
+
+const (
+	// the tiles of a guess flip over one at a time to show their colors
+	revealAnimation animationKind = iota
+	// the row being typed in shakes from side to side when its word can't be
+	// guessed
+	shakeAnimation
+	// the tiles of the winning guess hop one after another
+	bounceAnimation
+)
+
+// animation is played on the boards a frame at a time
+type animation struct {
+	kind animationKind
+	// row of the guesses a reveal or bounce is played on
+	row           int
+	frame, frames int
+	// closed once the animation is over, which stops its ticker
+	stop chan struct{}
+}
+
+// starts playing an animation on a row of the guesses, in place of the one
+// being played. A ticker asks the gui to draw each frame, so animations are
+// only ever touched by the gui's goroutine.
+func (s *Session) animate(g *gocui.Gui, kind animationKind, row int) {
+	if !s.animations {
+		return
+	}
+	s.stopAnimation()
+	a := &animation{kind: kind, row: row, stop: make(chan struct{})}
+	var interval time.Duration
+	switch kind {
+	case revealAnimation:
+		// every tile is shown halfway through flipping before its color
+		a.frames, interval = 2*s.wordLen(), REVEAL_FRAME
+	case shakeAnimation:
+		a.frames, interval = len(shakeOffsets), SHAKE_FRAME
+	case bounceAnimation:
+		a.frames, interval = s.wordLen(), BOUNCE_FRAME
+	}
+	s.animation = a
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-a.stop:
+				return
+			case <-ticker.C:
+				g.Update(func(g *gocui.Gui) error {
+					return s.nextFrame(g, a)
+				})
+			}
+		}
+	}()
+}
+
+// moves an animation on to its next frame, and bounces the winning guess once
+// it has been revealed
+func (s *Session) nextFrame(g *gocui.Gui, a *animation) error {
+	// frames can still be on their way after the animation was stopped
+	if s.animation != a {
+		return nil
+	}
+	a.frame++
+	if a.frame == a.frames {
+		s.stopAnimation()
+		if a.kind == revealAnimation && s.game.State() == wordle.Won {
+			s.animate(g, bounceAnimation, a.row)
+		}
+	}
+	return s.redraw(g)
+}
+
+// stops the animation being played, if there is one, leaving the boards to
+// be drawn as they are. Keys that change the game stop animations, so they
+// never hold up a player who types quickly.
+func (s *Session) stopAnimation() {
+	if s.animation != nil {
+		close(s.animation.stop)
+		s.animation = nil
+	}
+}
+
+// whether the last guess is still being revealed, in which case what it
+// revealed isn't shown anywhere else yet either
+func (s *Session) revealing() bool {
+	return s.animation != nil && s.animation.kind == revealAnimation
+}
+
+// changes the tiles of the guess in row i of a board to how they look in the
+// current frame of the animation
+func (s *Session) animateTiles(tiles []tile, i int) []tile {
+	a := s.animation
+	if a == nil || a.row != i {
+		return tiles
+	}
+	for col := range tiles {
+		switch a.kind {
+		case revealAnimation:
+			switch {
+			case a.frame < 2*col:
+				tiles[col] = tile{letter: tiles[col].letter}
+			case a.frame == 2*col:
+				tiles[col].flipping = true
+			}
+		case bounceAnimation:
+			tiles[col].raised = a.frame == col
+		}
+	}
+	return tiles
+}
+
+// columns the row being typed in is moved by in the current frame
+func (s *Session) shakeOffset() int {
+	if a := s.animation; a != nil && a.kind == shakeAnimation {
+		return shakeOffsets[a.frame]
+	}
+	return 0
+}
+
+// whether f is a terminal rather than a file or a pipe. Animations are only
+// played on terminals.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
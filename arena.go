@@ -0,0 +1,233 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/bot"
+	"github.com/x2dtu/wordle/wordle/solver"
+)
+
+// command lines of the bots in an arena, given by repeating -bot
+type botList []string
+
+func (b *botList) String() string {
+	return strings.Join(*b, ", ")
+}
+
+func (b *botList) Set(command string) error {
+	if len(strings.Fields(command)) == 0 {
+		return errors.New("empty bot command")
+	}
+	*b = append(*b, command)
+	return nil
+}
+
+// how the games of one bot in an arena went
+type arenaScore struct {
+	command  string
+	won      int
+	lost     int
+	forfeits map[string]int // by reason
+	// Distribution[i] is the number of games won in i+1 guesses
+	distribution []int
+	elapsed      time.Duration
+}
+
+// runs "wordle arena": every bot plays the same games, one after another,
+// and the results are compared in a table
+func runArena(args []string) int {
+	flags := flag.NewFlagSet("arena", flag.ExitOnError)
+	var bots botList
+	flags.Var(&bots, "bot", "command that starts a bot, split on spaces (repeat to compare bots; the arguments after the flags are one too)")
+	games := flags.Int("games", 100, "number of games each bot plays")
+	seed := flags.Int64("seed", 1, "seed of the first game; the rest follow from it like practice games")
+	timeout := flags.Duration("timeout", 5*time.Second, "how long a bot has to make each guess")
+	length := flags.Int("length", wordle.WordLength, "number of letters in the words")
+	tries := flags.Int("tries", wordle.MaxGuesses, "number of guesses allowed, or 0 for no limit")
+	hard := flags.Bool("hard", false, "play in hard mode")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: wordle arena [flags] [bot command...]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() > 0 {
+		bots = append(bots, strings.Join(flags.Args(), " "))
+	}
+	if len(bots) == 0 {
+		flags.Usage()
+		return 2
+	}
+	if !wordle.ValidLength(*length) {
+		fmt.Fprintf(os.Stderr, "-length must be between %d and %d\n", wordle.MinLength, wordle.MaxLength)
+		return 2
+	}
+	if *tries < 0 {
+		fmt.Fprintln(os.Stderr, "-tries can't be negative")
+		return 2
+	} else if *tries == 0 {
+		*tries = wordle.Unlimited
+	}
+
+	opts := []wordle.Option{wordle.WithLength(*length), wordle.WithTries(*tries)}
+	if *hard {
+		opts = append(opts, wordle.WithDifficulty(wordle.Hard))
+	}
+	seeds := make([]int64, *games)
+	for i := range seeds {
+		seeds[i] = *seed
+		*seed = rand.New(rand.NewSource(*seed)).Int63n(MAX_SEED)
+	}
+
+	scores := make([]*arenaScore, len(bots))
+	var wg sync.WaitGroup
+	for i, command := range bots {
+		wg.Add(1)
+		go func(i int, command string) {
+			defer wg.Done()
+			scores[i] = playArena(command, seeds, opts, *timeout)
+		}(i, command)
+	}
+	wg.Wait()
+
+	printArena(scores, *games)
+	return 0
+}
+
+// has the bot started by command play a game with each of the seeds
+func playArena(command string, seeds []int64, opts []wordle.Option, timeout time.Duration) *arenaScore {
+	score := &arenaScore{command: command, forfeits: make(map[string]int)}
+	start := time.Now()
+	defer func() {
+		score.elapsed = time.Since(start)
+	}()
+
+	fields := strings.Fields(command)
+	var p *bot.Process
+	for i, seed := range seeds {
+		// bots are restarted after anything that could leave them out of step
+		if p == nil {
+			var err error
+			if p, err = bot.Start(fields[0], fields[1:]...); err != nil {
+				score.lost += len(seeds) - i
+				score.forfeits[err.Error()] += len(seeds) - i
+				return score
+			}
+		}
+
+		w := wordle.New(append(opts[:len(opts):len(opts)], wordle.WithSeed(seed))...)
+		result := p.Play(i+1, w, timeout)
+		switch {
+		case result.Err != nil:
+			score.lost++
+			reason := result.Err.Error()
+			if errors.Is(result.Err, bot.ErrInvalidGuess) {
+				reason = bot.ErrInvalidGuess.Error()
+			} else {
+				p.Close()
+				p = nil
+			}
+			score.forfeits[reason]++
+		case result.Won:
+			score.won++
+			for len(score.distribution) < result.Guesses {
+				score.distribution = append(score.distribution, 0)
+			}
+			score.distribution[result.Guesses-1]++
+		default:
+			score.lost++
+		}
+	}
+	if p != nil {
+		p.Close()
+	}
+	return score
+}
+
+func printArena(scores []*arenaScore, games int) {
+	most := 0
+	for _, score := range scores {
+		if len(score.distribution) > most {
+			most = len(score.distribution)
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprint(w, "bot\tgames\twon\tfailed\tmean\t")
+	for i := 1; i <= most; i++ {
+		fmt.Fprintf(w, "%d\t", i)
+	}
+	fmt.Fprintln(w, "time\t")
+	for _, score := range scores {
+		guesses := 0
+		for i, count := range score.distribution {
+			guesses += (i + 1) * count
+		}
+		mean := "-"
+		if score.won > 0 {
+			mean = fmt.Sprintf("%.3f", float64(guesses)/float64(score.won))
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\t", score.command, games, score.won, score.lost, mean)
+		for i := 0; i < most; i++ {
+			count := 0
+			if i < len(score.distribution) {
+				count = score.distribution[i]
+			}
+			fmt.Fprintf(w, "%d\t", count)
+		}
+		fmt.Fprintf(w, "%s\t\n", score.elapsed.Round(time.Millisecond))
+	}
+	w.Flush()
+
+	for _, score := range scores {
+		for reason, count := range score.forfeits {
+			fmt.Printf("%s forfeited %d game(s): %s\n", score.command, count, reason)
+		}
+	}
+}
+
+// a bot that plays with the built-in solver
+type solverPlayer struct {
+	strategy solver.Strategy
+	solver   *solver.Solver
+}
+
+func (p *solverPlayer) NewGame(length int, difficulty wordle.Difficulty) {
+	p.solver = solver.New(solver.WithStrategy(p.strategy), solver.WithLength(length), solver.WithDifficulty(difficulty))
+}
+
+func (p *solverPlayer) Guess() string {
+	return p.solver.Best()
+}
+
+func (p *solverPlayer) Update(guess string, feedback wordle.Feedback) {
+	p.solver.Update(guess, feedback)
+}
+
+// runs "wordle bot": the built-in solver playing over the bot protocol, as an
+// example and an opponent for other bots
+func runBot(args []string) int {
+	flags := flag.NewFlagSet("bot", flag.ExitOnError)
+	strategy := flags.String("strategy", solver.Entropy.String(), "how guesses are ranked: entropy, minimax or expected")
+	flags.Parse(args)
+
+	s, err := solver.ParseStrategy(*strategy)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if err := bot.Serve(os.Stdin, os.Stdout, &solverPlayer{strategy: s}); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
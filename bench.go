@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/solver"
+)
+
+// results of a benchmark of the solver over every answer
+type benchResult struct {
+	strategy solver.Strategy
+	start    string
+	length   int
+	answers  int
+	failures int
+	worst    int
+	// distribution[i] is the number of answers found in i+1 guesses
+	distribution []int
+	elapsed      time.Duration
+}
+
+func (r *benchResult) mean() float64 {
+	guesses, solved := 0, 0
+	for i, count := range r.distribution {
+		guesses += (i + 1) * count
+		solved += count
+	}
+	if solved == 0 {
+		return 0
+	}
+	return float64(guesses) / float64(solved)
+}
+
+// runs "wordle bench": the solver plays a game against every answer, and the
+// results are summed up so changes to the solver can be compared
+func runBench(args []string) int {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	strategy := flags.String("strategy", solver.Entropy.String(), "how guesses are ranked: entropy, minimax or expected")
+	start := flags.String("start", "", "first guess of every game (default the strategy's own)")
+	length := flags.Int("length", wordle.WordLength, "number of letters in the words")
+	tries := flags.Int("tries", wordle.MaxGuesses, "games not solved in this many guesses count as failures, or 0 for no limit")
+	workers := flags.Int("workers", runtime.GOMAXPROCS(0), "number of games played at once")
+	asCSV := flags.Bool("csv", false, "print the results as CSV, with a header row, instead of a table")
+	flags.Parse(args)
+
+	s, err := solver.ParseStrategy(*strategy)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if !wordle.ValidLength(*length) {
+		fmt.Fprintf(os.Stderr, "-length must be between %d and %d\n", wordle.MinLength, wordle.MaxLength)
+		return 2
+	}
+	*start = strings.ToLower(*start)
+	if *start != "" && (len(*start) != *length || !wordle.IsLegal(*start)) {
+		fmt.Fprintf(os.Stderr, "-start %q isn't a valid %d letter guess\n", *start, *length)
+		return 2
+	}
+	if *tries < 0 {
+		fmt.Fprintln(os.Stderr, "-tries can't be negative")
+		return 2
+	} else if *tries == 0 {
+		*tries = wordle.Unlimited
+	}
+	if *workers < 1 {
+		*workers = 1
+	}
+
+	result := bench(s, *start, *length, *tries, *workers)
+	if *asCSV {
+		printBenchCSV(result)
+	} else {
+		printBenchTable(result)
+	}
+	return 0
+}
+
+// plays a game against every answer of the given length, with that many
+// games at once. The daily puzzles go through the answers in order, so
+// puzzles 0 to len(answers)-1 cover each of them once.
+func bench(strategy solver.Strategy, start string, length int, tries int, workers int) *benchResult {
+	result := &benchResult{strategy: strategy, start: start, length: length, answers: len(wordle.Answers(length))}
+	// every number of guesses that could win gets a column, even if no game
+	// took that many, so runs with the same settings line up
+	if tries == wordle.Unlimited {
+		result.distribution = make([]int, wordle.MaxGuesses)
+	} else {
+		result.distribution = make([]int, tries)
+	}
+	began := time.Now()
+
+	// the solver's guess only depends on what it has seen so far, so the
+	// guesses made after each history are shared by every game
+	var mu sync.Mutex
+	memo := make(map[string]string)
+	next := func(history string, s *solver.Solver) string {
+		mu.Lock()
+		guess, ok := memo[history]
+		mu.Unlock()
+		if !ok {
+			guess = s.Best()
+			mu.Lock()
+			memo[history] = guess
+			mu.Unlock()
+		}
+		return guess
+	}
+
+	puzzles := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for puzzle := range puzzles {
+				w := wordle.New(wordle.WithLength(length), wordle.WithTries(tries), wordle.WithPuzzle(puzzle))
+				s := solver.New(solver.WithStrategy(strategy), solver.WithLength(length))
+				history := ""
+				for !w.Over() {
+					guess := start
+					if guess == "" || w.Guesses() > 0 {
+						guess = next(history, s)
+					}
+					feedback, err := w.Guess(guess)
+					if err != nil {
+						break // the solver ran out of candidates
+					}
+					s.Update(guess, feedback)
+					history += guess + feedback.String() + " "
+				}
+
+				mu.Lock()
+				if w.State() == wordle.Won {
+					for len(result.distribution) < w.Guesses() {
+						result.distribution = append(result.distribution, 0)
+					}
+					result.distribution[w.Guesses()-1]++
+					if w.Guesses() > result.worst {
+						result.worst = w.Guesses()
+					}
+				} else {
+					result.failures++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for puzzle := 0; puzzle < result.answers; puzzle++ {
+		puzzles <- puzzle
+	}
+	close(puzzles)
+	wg.Wait()
+
+	result.elapsed = time.Since(began)
+	return result
+}
+
+func printBenchTable(r *benchResult) {
+	start := r.start
+	if start == "" {
+		start = "(" + r.strategy.String() + "'s own)"
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "strategy\t%s\n", r.strategy)
+	fmt.Fprintf(w, "start\t%s\n", start)
+	fmt.Fprintf(w, "answers\t%d\n", r.answers)
+	fmt.Fprintf(w, "mean guesses\t%.4f\n", r.mean())
+	fmt.Fprintf(w, "worst case\t%d\n", r.worst)
+	fmt.Fprintf(w, "failures\t%d\n", r.failures)
+	fmt.Fprintf(w, "wall time\t%s\n", r.elapsed.Round(time.Millisecond))
+	w.Flush()
+
+	fmt.Println()
+	most := 1
+	for _, count := range r.distribution {
+		if count > most {
+			most = count
+		}
+	}
+	for i, count := range r.distribution {
+		fmt.Printf("%2d %-*s %d\n", i+1, MAX_BAR_LEN, strings.Repeat("█", count*MAX_BAR_LEN/most), count)
+	}
+}
+
+// prints the results as a header and a single row, so the output of runs can
+// be appended to each other to track the solver over time
+func printBenchCSV(r *benchResult) {
+	header := []string{"strategy", "start", "length", "answers", "mean", "worst", "failures", "wall_ms"}
+	row := []string{
+		r.strategy.String(),
+		r.start,
+		fmt.Sprint(r.length),
+		fmt.Sprint(r.answers),
+		fmt.Sprintf("%.4f", r.mean()),
+		fmt.Sprint(r.worst),
+		fmt.Sprint(r.failures),
+		fmt.Sprint(r.elapsed.Milliseconds()),
+	}
+	for i, count := range r.distribution {
+		header = append(header, fmt.Sprintf("guesses_%d", i+1))
+		row = append(row, fmt.Sprint(count))
+	}
+
+	w := csv.NewWriter(os.Stdout)
+	w.Write(header)
+	w.Write(row)
+	w.Flush()
+}
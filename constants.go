@@ -1,43 +1,26 @@
 package main
 
 // for formatting of console:
-const WORD_LEN = 5
-const NUM_TRIES = 6
-const WORD_START = 8
-const SPACE = "        "
+const INPUT_WIDTH = 22       // words on the board are centered within this width
+const BOARD_MESSAGE_ROWS = 9 // rows under the guesses for the end of game messages
+const UNLIMITED_ROWS = 6     // rows visible at once when tries are unlimited
+const GRID_MESSAGE_ROWS = 6  // rows under a grid of boards for the end of game messages
+const MAX_BOARDS = 8
 
-// ansi color codes:
-const GREEN = "\u001b[32m"
-const YELLOW = "\u001b[33m"
-const RED = "\u001b[31m"
-const BLUE = "\u001b[34m"
-const CYAN = "\u001b[36m"
-const RESET = "\u001b[0m"
-const GRAY = "\u001b[30;1m"
-
-const KEYBOARD_START = "Q  W  E  R  T  Y  U  I  O  P" // used to get width of keyboard view
-const ALPHABET_LEN = 26
+const DATE_FORMAT = "2006-01-02"
 
-var KEYBOARD = [ALPHABET_LEN]string{
-	"Q", "W", "E", "R", "T", "Y", "U", "I", "O", "P",
-	"\n\n A", "S", "D", "F", "G", "H", "J", "K", "L",
-	"\n\n    Z", "X", "C", "V", "B", "N", "M"}
+// practice seeds are kept short so they're easy to read off the title
+const MAX_SEED = 1000000
 
-// colored keyboard is initialized in init() to be a copy of KEYBOARD that will have ansi color codes embedded within
-var COLORED_KEYBOARD = make([]string, ALPHABET_LEN)
-
-// keyboard positions has index for letter in keyboard array. For example:
-// KEYBOARD_POSITIONS['a' - 'a'] = 10, and KEYBOARD[10] = 'A'
-var KEYBOARD_POSITIONS = [ALPHABET_LEN]int{10, 23, 21, 12, 2, 13, 14, 15, 7, 16, 17, 18, 25, 24, 8, 9, 0, 3, 11, 4, 6, 22, 1, 20, 5, 19}
+// ansi codes, the colors come from the theme:
+const RESET = "\u001b[0m"
+const UNDERLINE = "\u001b[4m"
+const REVERSE = "\u001b[7m"
 
-func init() {
-	initKeyboard()
-}
+const ALPHABET_LEN = 26
+const KEY_GAP = 2 // spaces between the keys of the keyboard
 
-// turns each letter in keyboard blue, signifying that they havent been used in a word yet
-func initKeyboard() {
-	for i := 0; i < len(KEYBOARD); i++ {
-		// COLORED_KEYBOARD[i] = BLUE + KEYBOARD[i] + RESET
-		COLORED_KEYBOARD[i] = CYAN + KEYBOARD[i] + RESET
-	}
-}
+// rows of the visual keyboard and how far each is indented, in thirds of a key
+// and the gap after it
+var KEYBOARD_ROWS = [3]string{"QWERTYUIOP", "ASDFGHJKL", "ZXCVBNM"}
+var KEYBOARD_INDENTS = [3]int{0, 1, 4}
@@ -2,7 +2,12 @@ module github.com/x2dtu/wordle
 
 go 1.19
 
-require github.com/jroimartin/gocui v0.5.0
+require (
+	github.com/BurntSushi/toml v1.3.2
+	github.com/jroimartin/gocui v0.5.0
+	golang.org/x/crypto v0.17.0
+	golang.org/x/sys v0.15.0
+)
 
 require (
 	github.com/mattn/go-runewidth v0.0.9 // indirect
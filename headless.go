@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// exit codes of a headless game
+const EXIT_WON = 0
+const EXIT_LOST = 1
+const EXIT_UNFINISHED = 3 // the input ran out before the game was over
+
+// one line of JSON output of a headless game. The first line describes the
+// game, and every line after it answers a guess.
+type headlessLine struct {
+	Length int `json:"length,omitempty"`
+	Tries  int `json:"tries,omitempty"`
+	Boards int `json:"boards,omitempty"`
+
+	Guess string `json:"guess,omitempty"`
+	// feedback of each board, with dashes for boards that were already solved
+	Feedback []string `json:"feedback,omitempty"`
+	Error    string   `json:"error,omitempty"`
+
+	State   string `json:"state"`
+	Guesses int    `json:"guesses"`
+	// targets are only given away once the game is over
+	Targets []string `json:"targets,omitempty"`
+}
+
+// plays game without the gui, for scripts and bots: guesses are read from in,
+// one per line, and the answer to each is written to out. As text, valid
+// guesses get their feedback like GYBBG (one per board, separated by spaces)
+// and invalid ones a line starting with "!"; with asJSON every line is a JSON
+// object. It returns the exit code of the program.
+func playHeadless(game *wordle.Game, in io.Reader, out io.Writer, asJSON bool) int {
+	encoder := json.NewEncoder(out)
+	if asJSON {
+		encoder.Encode(headlessLine{
+			Length:  game.Length(),
+			Tries:   game.Tries(),
+			Boards:  len(game.Boards()),
+			State:   game.State().String(),
+			Guesses: game.Guesses(),
+		})
+	}
+
+	scanner := bufio.NewScanner(in)
+	for !game.Over() && scanner.Scan() {
+		guess := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if guess == "" {
+			continue
+		}
+
+		line := headlessLine{Guess: guess}
+		feedback, err := game.Guess(guess)
+		if err != nil {
+			line.Error = err.Error()
+		} else {
+			for _, f := range feedback {
+				if f == nil {
+					line.Feedback = append(line.Feedback, strings.Repeat("-", game.Length()))
+				} else {
+					line.Feedback = append(line.Feedback, f.String())
+				}
+			}
+		}
+		line.State = game.State().String()
+		line.Guesses = game.Guesses()
+		if game.Over() {
+			for _, board := range game.Boards() {
+				line.Targets = append(line.Targets, board.Target())
+			}
+		}
+
+		if asJSON {
+			encoder.Encode(line)
+			continue
+		}
+		if err != nil {
+			fmt.Fprintf(out, "! %v\n", err)
+			continue
+		}
+		fmt.Fprintln(out, strings.Join(line.Feedback, " "))
+		switch game.State() {
+		case wordle.Won:
+			fmt.Fprintf(out, "won in %d\n", game.Guesses())
+		case wordle.Lost:
+			if len(line.Targets) == 1 {
+				fmt.Fprintf(out, "lost, the answer was %s\n", line.Targets[0])
+			} else {
+				fmt.Fprintf(out, "lost, the answers were %s\n", strings.Join(line.Targets, " "))
+			}
+		}
+	}
+
+	switch game.State() {
+	case wordle.Won:
+		return EXIT_WON
+	case wordle.Lost:
+		return EXIT_LOST
+	default:
+		return EXIT_UNFINISHED
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// seed 1 picks ledge
+func TestPlayHeadless(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []wordle.Option
+		in      string
+		asJSON  bool
+		want    string
+		code    int
+	}{
+		{
+			name: "won",
+			in:   "crane\n\n  XXXXX \nLedge\nextra\n",
+			want: "BBBBG\n! not in word list\nGGGGG\nwon in 2\n",
+			code: EXIT_WON,
+		},
+		{
+			name:    "lost",
+			options: []wordle.Option{wordle.WithTries(2)},
+			in:      "crane\nplate\n",
+			want:    "BBBBG\nBYBBG\nlost, the answer was ledge\n",
+			code:    EXIT_LOST,
+		},
+		{
+			name: "unfinished",
+			in:   "crane\nabc",
+			want: "BBBBG\n! wrong number of letters\n",
+			code: EXIT_UNFINISHED,
+		},
+		{
+			name:   "json won",
+			in:     "xxxxx\nledge\n",
+			asJSON: true,
+			want: `{"length":5,"tries":6,"boards":1,"state":"playing","guesses":0}
+{"guess":"xxxxx","error":"not in word list","state":"playing","guesses":0}
+{"guess":"ledge","feedback":["GGGGG"],"state":"won","guesses":1,"targets":["ledge"]}
+`,
+			code: EXIT_WON,
+		},
+		{
+			name:    "json lost",
+			options: []wordle.Option{wordle.WithTries(1)},
+			in:      "crane\n",
+			asJSON:  true,
+			want: `{"length":5,"tries":1,"boards":1,"state":"playing","guesses":0}
+{"guess":"crane","feedback":["BBBBG"],"state":"lost","guesses":1,"targets":["ledge"]}
+`,
+			code: EXIT_LOST,
+		},
+		{
+			name:   "json unfinished",
+			in:     "",
+			asJSON: true,
+			want:   `{"length":5,"tries":6,"boards":1,"state":"playing","guesses":0}` + "\n",
+			code:   EXIT_UNFINISHED,
+		},
+	}
+	for _, tt := range tests {
+		game := wordle.NewGame(1, append(tt.options, wordle.WithSeed(1))...)
+		var out strings.Builder
+		code := playHeadless(game, strings.NewReader(tt.in), &out, tt.asJSON)
+		if code != tt.code {
+			t.Errorf("%s: exit code %d, want %d", tt.name, code, tt.code)
+		}
+		if out.String() != tt.want {
+			t.Errorf("%s: output\n%s\nwant\n%s", tt.name, out.String(), tt.want)
+		}
+	}
+}
+
+// boards that are already solved get dashes instead of feedback
+func TestPlayHeadlessBoards(t *testing.T) {
+	game := wordle.NewGame(2, wordle.WithSeed(42))
+	first, second := game.Boards()[0].Target(), game.Boards()[1].Target()
+	var out strings.Builder
+	code := playHeadless(game, strings.NewReader(first+"\n"+second+"\n"), &out, true)
+	want := `{"length":5,"tries":7,"boards":2,"state":"playing","guesses":0}
+{"guess":"` + first + `","feedback":["GGGGG","` + wordle.Score(first, second).String() + `"],"state":"playing","guesses":1}
+{"guess":"` + second + `","feedback":["-----","GGGGG"],"state":"won","guesses":2,"targets":["` + first + `","` + second + `"]}
+`
+	if code != EXIT_WON || out.String() != want {
+		t.Errorf("exit code %d with output\n%s\nwant %d with\n%s", code, out.String(), EXIT_WON, want)
+	}
+}
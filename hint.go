@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jroimartin/gocui"
+	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/solver"
+)
+
+const HINT_WIDTH = 26
+const HINT_SAMPLE = 8
+
+// where the hint panel goes: right of the first row of boards, and as tall as
+// it
+func (s *Session) hintBounds(g *gocui.Gui) (int, int, int, int, error) {
+	maxX, _ := g.Size()
+	cols, _ := s.boardGrid(maxX)
+	_, y0, x1, y1, err := g.ViewPosition(boardName(cols - 1))
+	return x1 + 2, y0, x1 + 2 + HINT_WIDTH, y1, err
+}
+
+// opens a panel next to the boards with the answers that are still possible
+// and the solver's suggested guess, or closes it if it's open. Opening it
+// counts as using a hint.
+func (s *Session) toggleHint(g *gocui.Gui, v *gocui.View) error {
+	if _, err := g.View("hint"); err == nil {
+		return g.DeleteView("hint")
+	}
+	if s.game.Over() {
+		return nil
+	}
+
+	x0, y0, x1, y1, err := s.hintBounds(g)
+	if err != nil {
+		return err
+	}
+	v, err = g.SetView("hint", x0, y0, x1, y1)
+	if err != nil && err != gocui.ErrUnknownView {
+		return err
+	}
+	v.Title = " Hint "
+	fmt.Fprintln(v, " Thinking...")
+
+	s.game.UseHint()
+	if err := s.saveGame(); err != nil {
+		s.setStatus(g, "Couldn't save the game")
+	}
+
+	// ranking guesses can take a moment, so it's done off the gui goroutine.
+	// It works from a copy of the boards, since the game can move on while
+	// it does.
+	game := s.game
+	length, lies, difficulty := game.Length(), game.Lies(), game.Difficulty()
+	var boards [][]wordle.Row
+	var over []bool
+	for _, board := range game.Boards() {
+		boards = append(boards, append([]wordle.Row(nil), board.Rows()...))
+		over = append(over, board.Over())
+	}
+	go func() {
+		solvers := make([]*solver.Solver, len(boards))
+		for i, rows := range boards {
+			solvers[i] = solver.New(solver.WithLength(length), solver.WithLies(lies), solver.WithDifficulty(difficulty))
+			for _, row := range rows {
+				solvers[i].Update(row.Word, row.Feedback)
+			}
+		}
+		// the suggestion is for the unsolved board closest to being solved
+		closest := -1
+		for i, sv := range solvers {
+			if over[i] {
+				continue
+			}
+			if closest < 0 || len(sv.Candidates()) < len(solvers[closest].Candidates()) {
+				closest = i
+			}
+		}
+		best := solvers[closest].Best()
+		// the guess also has to respect the hard mode hints of the other
+		// boards, so the next best one is suggested if it doesn't
+		if best != "" && !followsHints(best, boards, over, difficulty) {
+			best = ""
+			for _, suggestion := range solvers[closest].Rank(len(wordle.LegalGuesses(length))) {
+				if followsHints(suggestion.Word, boards, over, difficulty) {
+					best = suggestion.Word
+					break
+				}
+			}
+		}
+		g.Update(func(g *gocui.Gui) error {
+			v, err := g.View("hint")
+			if err != nil || game != s.game {
+				return nil // closed or a new game started in the meantime
+			}
+			v.Clear()
+			if len(solvers) == 1 {
+				s.printHint(v, solvers[0].Candidates(), best)
+			} else {
+				s.printBoardsHint(v, solvers, over, best)
+			}
+			return nil
+		})
+	}()
+	return nil
+}
+
+// reports whether word respects the hints of every board still being played
+// for the given difficulty
+func followsHints(word string, boards [][]wordle.Row, over []bool, difficulty wordle.Difficulty) bool {
+	for i, rows := range boards {
+		if !over[i] && wordle.CheckHints(word, rows, difficulty) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Session) printHint(v *gocui.View, candidates []string, best string) {
+	if len(candidates) == 1 {
+		fmt.Fprintln(v, " 1 possible answer")
+	} else {
+		fmt.Fprintf(v, " %d possible answers\n", len(candidates))
+	}
+	fmt.Fprintln(v)
+
+	sample := candidates
+	if len(sample) > HINT_SAMPLE {
+		sample = sample[:HINT_SAMPLE]
+	}
+	for _, word := range sample {
+		fmt.Fprintf(v, "   %s\n", word)
+	}
+	if len(candidates) > len(sample) {
+		fmt.Fprintf(v, "   ...and %d more\n", len(candidates)-len(sample))
+	}
+	s.printSuggestion(v, best)
+}
+
+// prints how many answers are left on each unsolved board, and the guess
+// suggested for the one with the fewest
+func (s *Session) printBoardsHint(v *gocui.View, solvers []*solver.Solver, over []bool, best string) {
+	for i, sv := range solvers {
+		if over[i] {
+			continue
+		}
+		if n := len(sv.Candidates()); n == 1 {
+			fmt.Fprintf(v, " Board %d: 1 answer\n", i+1)
+		} else {
+			fmt.Fprintf(v, " Board %d: %d answers\n", i+1, n)
+		}
+	}
+	s.printSuggestion(v, best)
+}
+
+func (s *Session) printSuggestion(v *gocui.View, best string) {
+	if best != "" {
+		fmt.Fprintln(v)
+		fmt.Fprintf(v, " Try: %s%s%s\n", s.colors.Correct, strings.ToUpper(best), RESET)
+	}
+}
+
+// closes the hint panel, since it's out of date once a guess is made
+func closeHint(g *gocui.Gui) {
+	if _, err := g.View("hint"); err == nil {
+		g.DeleteView("hint")
+	}
+}
+
+// starts working out the solver's first guess so the first hint is quick
+func warmUpSolver(length int) {
+	go solver.Opening(solver.Entropy, length)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jroimartin/gocui"
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// works out the color of every key for one board from what its guesses
+// revealed, leaving out a guess that is still being revealed. Keys start out
+// in the unused color, signifying that they haven't been used in a word yet.
+func (s *Session) keyColors(board *wordle.Wordle) [ALPHABET_LEN]string {
+	var keyboard wordle.Keyboard
+	for i, row := range board.Rows() {
+		if !s.revealing() || i != s.animation.row {
+			keyboard.Update(row)
+		}
+	}
+	var colors [ALPHABET_LEN]string
+	for i, state := range keyboard {
+		switch state {
+		case wordle.KeyCorrect:
+			colors[i] = s.colors.Correct
+		case wordle.KeyPresent:
+			colors[i] = s.colors.Present
+		case wordle.KeyAbsent:
+			colors[i] = s.colors.Muted
+		default:
+			colors[i] = s.colors.Unused
+		}
+	}
+	return colors
+}
+
+// number of lines each key takes up. Keys of games with many boards are split
+// over two lines so the keyboard doesn't get too wide.
+func (s *Session) keyLines() int {
+	if len(s.game.Boards()) > 4 {
+		return 2
+	}
+	return 1
+}
+
+// number of columns each key takes up, one per board on each of its lines
+func (s *Session) keyWidth() int {
+	return (len(s.game.Boards()) + s.keyLines() - 1) / s.keyLines()
+}
+
+// width and height of the keyboard as printed by printKeyboard
+func (s *Session) keyboardSize() (int, int) {
+	width := len(KEYBOARD_ROWS[0])*(s.keyWidth()+KEY_GAP) - KEY_GAP
+	height := len(KEYBOARD_ROWS)*(s.keyLines()+1) - 1
+	return width, height
+}
+
+// prints the keyboard with every key colored by what the guesses revealed
+// about its letter. With more than one board each key is split up, showing
+// its letter once for each board in that board's color.
+func (s *Session) printKeyboard(v *gocui.View) {
+	boards := s.game.Boards()
+	colors := make([][ALPHABET_LEN]string, len(boards))
+	for i, board := range boards {
+		colors[i] = s.keyColors(board)
+	}
+
+	width := s.keyWidth()
+	for r, row := range KEYBOARD_ROWS {
+		if r > 0 {
+			fmt.Fprintln(v)
+		}
+		indent := strings.Repeat(" ", KEYBOARD_INDENTS[r]*(width+KEY_GAP)/3)
+		for line := 0; line < s.keyLines(); line++ {
+			keys := make([]string, len(row))
+			for k, char := range row {
+				var key strings.Builder
+				for b := line * width; b < (line+1)*width; b++ {
+					if b < len(boards) {
+						fmt.Fprintf(&key, "%s%c%s", colors[b][char-'A'], char, RESET)
+					} else {
+						key.WriteString(" ")
+					}
+				}
+				keys[k] = key.String()
+			}
+			fmt.Fprintln(v, indent+strings.Join(keys, strings.Repeat(" ", KEY_GAP)))
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jroimartin/gocui"
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// layoutMode is how much of the game is shown around the boards, which
+// depends on the size of the terminal
+type layoutMode int
+
+const (
+	// the title, the description, the boards, the status line and the
+	// keyboard, with blank lines between them
+	fullLayout layoutMode = iota
+	// the title right above the boards, the status line and the keyboard
+	compactLayout
+	// only the boards and the status line
+	minimalLayout
+)
+
+// shown over everything else when even the smallest layout doesn't fit, in
+// lines short enough for narrow terminals
+var tooSmallMessage = []string{
+	"Your terminal is too",
+	"small to play Wordle.",
+	"",
+	"Make it bigger to",
+	"keep playing.",
+}
+
+// lays out every view for the current size of the terminal. It's called
+// before every redraw of the screen, so the game follows the terminal as it
+// gets resized.
+func (s *Session) layout(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+
+	tiles := s.tiles
+	fits := s.pickLayout(maxX, maxY)
+	at := s.geometry(maxX)
+
+	title := s.game.Name()
+	switch s.game.Mode() {
+	case wordle.Daily:
+		title = fmt.Sprintf("%s %d", s.game.Name(), s.game.Puzzle())
+	case wordle.Practice:
+		title = fmt.Sprintf("%s (seed %d)", s.game.Name(), s.game.Seed())
+	}
+
+	// the title is redrawn every time since it changes when switching to practice
+	if s.layoutMode == minimalLayout {
+		g.DeleteView("title")
+	} else {
+		v, err := g.SetView("title", maxX/2-len(title)/2, at.startTitleY, maxX/2+len(title), at.endTitleY)
+		if err != nil {
+			if err != gocui.ErrUnknownView {
+				return err
+			}
+			v.Frame = false
+		}
+		v.Clear()
+		fmt.Fprintln(v, title)
+	}
+
+	description := "Guess the Hidden Word!"
+	if s.boards > 1 {
+		description = fmt.Sprintf("Guess the %d Hidden Words!", s.boards)
+	}
+	if s.layoutMode != fullLayout {
+		g.DeleteView("description")
+	} else if v, err := g.SetView("description", maxX/2-len(description)/2, at.startDescriptionY, maxX+len(description)/2, at.endDescriptionY); err != nil {
+		if err != gocui.ErrUnknownView {
+			return err
+		}
+		v.Frame = false
+		fmt.Fprintln(v, description)
+	}
+
+	created := false
+	if s.boards == 1 {
+		if _, err := g.SetView("input", maxX/2-s.inputWidth()/2, at.startInputY, maxX/2+s.inputWidth()/2, at.endInputY); err != nil {
+			if err != gocui.ErrUnknownView {
+				return err
+			}
+			created = true
+		}
+	} else {
+		// boards are laid out left to right and top to bottom, centered
+		boardWidth := s.wordWidth() + 4
+		left := maxX/2 - (at.cols*(boardWidth+1)-1)/2
+		for i := 0; i < s.boards; i++ {
+			x0 := left + i%at.cols*(boardWidth+1)
+			y0 := at.startInputY + i/at.cols*(s.gridBoardLines()+2)
+			if _, err := g.SetView(boardName(i), x0, y0, x0+boardWidth-1, y0+s.gridBoardLines()+1); err != nil {
+				if err != gocui.ErrUnknownView {
+					return err
+				}
+				created = true
+			}
+		}
+		if v, err := g.SetView("messages", maxX/2-INPUT_WIDTH/2, at.endGridY, maxX/2+INPUT_WIDTH/2, at.endInputY); err != nil {
+			if err != gocui.ErrUnknownView {
+				return err
+			}
+			v.Frame = false
+		}
+	}
+	if created {
+		if _, err := g.SetCurrentView("input"); err != nil {
+			return err
+		}
+	}
+
+	// frameless status line shown in the blank row between the input and the keyboard
+	if v, err := g.SetView("status", maxX/2-20, at.endInputY, maxX/2+20, at.endInputY+2); err != nil {
+		if err != gocui.ErrUnknownView {
+			return err
+		}
+		v.Frame = false
+	}
+
+	// the keyboard is printed by redraw, so it has to be redrawn when it
+	// comes back after the terminal was too small for it
+	redraw := created || s.tiles != tiles
+	keyboardWidth, _ := s.keyboardSize()
+	if s.layoutMode == minimalLayout {
+		g.DeleteView("keyboard")
+	} else if v, err := g.SetView("keyboard", maxX/2-keyboardWidth/2-1, at.startKeyboardY, maxX/2+keyboardWidth/2+1, at.endKeyboardY); err != nil {
+		if err != gocui.ErrUnknownView {
+			return err
+		}
+		v.Frame = false
+		redraw = true
+	}
+
+	// panels opened over the boards move along with them
+	if _, err := g.View("hint"); err == nil {
+		x0, y0, x1, y1, err := s.hintBounds(g)
+		if err != nil {
+			return err
+		}
+		if _, err := g.SetView("hint", x0, y0, x1, y1); err != nil {
+			return err
+		}
+	}
+	if _, err := g.View("stats"); err == nil {
+		x0, y0, x1, y1, err := s.statsBounds(g)
+		if err != nil {
+			return err
+		}
+		if _, err := g.SetView("stats", x0, y0, x1, y1); err != nil {
+			return err
+		}
+	}
+
+	if err := s.layoutTooSmall(g, !fits && !s.forcedLayout); err != nil {
+		return err
+	}
+
+	// a resumed game starts with guesses already on the board
+	if redraw {
+		return s.redraw(g)
+	}
+	return nil
+}
+
+// picks the biggest layout that fits a terminal of the given size, and with
+// -tiles auto the biggest tiles that fit in it, preferring to keep the
+// keyboard over bigger tiles. If nothing fits the smallest of both is picked
+// and false is returned.
+func (s *Session) pickLayout(maxX int, maxY int) bool {
+	for s.layoutMode = fullLayout; s.layoutMode <= minimalLayout; s.layoutMode++ {
+		if !s.autoTiles {
+			if s.fits(maxX, maxY) {
+				return true
+			}
+			continue
+		}
+		for s.tiles = boxedTiles; s.tiles >= compactTiles; s.tiles-- {
+			if s.fits(maxX, maxY) {
+				return true
+			}
+		}
+	}
+	s.layoutMode = minimalLayout
+	if s.autoTiles {
+		s.tiles = compactTiles
+	}
+	return false
+}
+
+// shows a message over the game asking for a bigger terminal, or takes it
+// away once the terminal is big enough again. While it's shown it gets the
+// keys, so nothing is typed into a game that can't be seen.
+func (s *Session) layoutTooSmall(g *gocui.Gui, tooSmall bool) error {
+	// with more than one board the blank tiles on each board show where the
+	// next letter goes. The cursor would be drawn over the message.
+	g.Cursor = s.boards == 1 && !tooSmall
+	if !tooSmall {
+		if err := g.DeleteView("small"); err != nil {
+			return nil // it wasn't shown
+		}
+		current := "input"
+		if _, err := g.View("stats"); err == nil {
+			current = "stats"
+		}
+		_, err := g.SetCurrentView(current)
+		return err
+	}
+
+	maxX, maxY := g.Size()
+	v, err := g.SetView("small", -1, -1, maxX, maxY)
+	if err != nil && err != gocui.ErrUnknownView {
+		return err
+	}
+	v.Frame = false
+	v.Wrap = true
+	v.Clear()
+	if pad := (maxY - len(tooSmallMessage)) / 2; pad > 0 {
+		fmt.Fprint(v, strings.Repeat("\n", pad))
+	}
+	for _, line := range tooSmallMessage {
+		if pad := (maxX - len(line)) / 2; pad > 0 {
+			line = strings.Repeat(" ", pad) + line
+		}
+		fmt.Fprintln(v, line)
+	}
+	if _, err := g.SetViewOnTop("small"); err != nil {
+		return err
+	}
+	_, err = g.SetCurrentView("small")
+	return err
+}
+
+// rows the views are laid out at, from the title down to the keyboard
+type geometry struct {
+	startTitleY, endTitleY             int
+	startDescriptionY, endDescriptionY int
+	startInputY, endInputY             int
+	// bottom of the grid of boards, when there is more than one
+	endGridY                     int
+	startKeyboardY, endKeyboardY int
+	// number of lines everything takes up
+	height int
+	// columns and rows of the grid of boards
+	cols, gridRows int
+}
+
+// works out where the views go in a terminal maxX wide with the current
+// layout and tiles
+func (s *Session) geometry(maxX int) geometry {
+	var at geometry
+	switch s.layoutMode {
+	case fullLayout:
+		at.startTitleY, at.endTitleY = 0, 2
+		at.startDescriptionY, at.endDescriptionY = at.endTitleY, at.endTitleY+2
+		at.startInputY = at.endDescriptionY + 2
+	case compactLayout:
+		// the title goes on the first line, right above the boards
+		at.startTitleY, at.endTitleY = -1, 1
+		at.startInputY = at.endTitleY
+	}
+	// a single board has a row per try, plus room for the messages shown when
+	// the game ends. A grid of boards has a row per try and one for the
+	// target, and the messages go under the grid.
+	at.endInputY = at.startInputY + s.boardLines() + BOARD_MESSAGE_ROWS + 1
+	at.cols, at.gridRows = s.boardGrid(maxX)
+	at.endGridY = at.startInputY + at.gridRows*(s.gridBoardLines()+2) - 1
+	if s.boards > 1 {
+		at.endInputY = at.endGridY + GRID_MESSAGE_ROWS + 1
+	}
+	_, keyboardHeight := s.keyboardSize()
+	at.startKeyboardY = at.endInputY + 1
+	at.endKeyboardY = at.startKeyboardY + keyboardHeight + 1
+	at.height = at.endKeyboardY
+	if s.layoutMode == minimalLayout {
+		// down to the status line
+		at.height = at.endInputY + 2
+	}
+	return at
+}
+
+// reports whether everything fits in a terminal of the given size with the
+// current layout and tiles
+func (s *Session) fits(maxX int, maxY int) bool {
+	width := s.inputWidth() + 1
+	if s.boards > 1 {
+		width = s.wordWidth() + 4
+	}
+	return width <= maxX && s.geometry(maxX).height <= maxY
+}
+
+// number of lines inside each board of a grid: its rows of tiles and one for
+// its target
+func (s *Session) gridBoardLines() int {
+	return (s.boardRows() + 1) * s.tiles.height()
+}
+
+// name of the view board i is drawn in. The first board is the input view,
+// which is the one keys are bound to.
+func boardName(i int) string {
+	if i == 0 {
+		return "input"
+	}
+	return fmt.Sprintf("board%d", i)
+}
+
+// number of columns and rows of boards that fit side by side in a terminal
+// maxX wide, keeping the rows as even as possible
+func (s *Session) boardGrid(maxX int) (int, int) {
+	cols := (maxX + 1) / (s.wordWidth() + 5)
+	if cols < 1 {
+		cols = 1
+	} else if cols > s.boards {
+		cols = s.boards
+	}
+	rows := (s.boards + cols - 1) / cols
+	return (s.boards + rows - 1) / rows, rows
+}
@@ -1,341 +1,471 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"math/rand"
 	"os"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/jroimartin/gocui"
 	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/store"
+	"github.com/x2dtu/wordle/wordle/theme"
 )
 
-var currWordle *wordle.Wordle
-var forcedLayout bool
-
 func main() {
-	flag.BoolVar(&forcedLayout, "f", false, "force game to play even with invalid terminal size")
+	// subcommands have flags of their own
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "arena":
+			os.Exit(runArena(os.Args[2:]))
+		case "bot":
+			os.Exit(runBot(os.Args[2:]))
+		case "bench":
+			os.Exit(runBench(os.Args[2:]))
+		case "serve":
+			os.Exit(runServe(os.Args[2:]))
+		case "ssh":
+			os.Exit(runSSH(os.Args[2:]))
+		}
+	}
+
+	s := &Session{dailyPuzzle: -1, sharePalette: wordle.Classic}
+	var hard, ultraHard, absurd, practice, startNew, highContrast, noAnimation, headless, asJSON bool
+	var length, tries, lies int
+	var date, epoch, timezone, statsPath, sharePath, themeName, colors, tiles string
+	flag.BoolVar(&s.forcedLayout, "f", false, "draw the game even when the terminal is too small for it, instead of asking for a bigger one")
+	flag.BoolVar(&hard, "hard", false, "hard mode: revealed hints must be used in subsequent guesses")
+	flag.BoolVar(&ultraHard, "ultra", false, "ultra hard mode: like hard mode, but gray letters and yellow spots can't be reused either")
+	flag.IntVar(&length, "length", wordle.WordLength, fmt.Sprintf("number of letters in the words, from %d to %d", wordle.MinLength, wordle.MaxLength))
+	flag.IntVar(&tries, "tries", wordle.MaxGuesses, "number of guesses allowed, or 0 to keep guessing until the word is found (default 5 more than -boards with more than one board)")
+	flag.IntVar(&s.boards, "boards", 1, fmt.Sprintf("number of boards to play at once, up to %d: 2 for Dordle, 4 for Quordle and 8 for Octordle", MAX_BOARDS))
+	flag.BoolVar(&absurd, "absurd", false, "adversarial mode, like Absurdle: the word is only picked once your guesses leave no choice (implies -practice and -tries 0)")
+	flag.IntVar(&lies, "lies", 0, "lying mode, like Fibble: this many tiles of every guess show the wrong color")
+	flag.BoolVar(&practice, "practice", false, "play practice games with random words instead of the daily puzzle")
+	flag.StringVar(&date, "date", "", "play the daily puzzle of a past day, formatted as YYYY-MM-DD")
+	flag.StringVar(&epoch, "epoch", wordle.Epoch.Format(DATE_FORMAT), "day of daily puzzle #0, formatted as YYYY-MM-DD")
+	flag.StringVar(&timezone, "tz", "Local", "timezone used to decide which day it is, e.g. America/New_York")
+	flag.Int64Var(&s.practiceSeed, "seed", 0, "seed for the practice words, shown in the title of each practice game (implies -practice)")
+	flag.BoolVar(&startNew, "new", false, "discard the saved game instead of resuming it")
+	flag.StringVar(&statsPath, "stats", "", "file to keep statistics in (default $XDG_DATA_HOME/wordle/stats.json)")
+	flag.BoolVar(&highContrast, "contrast", false, "use orange and blue squares in the share grid")
+	flag.StringVar(&themeName, "theme", "classic", fmt.Sprintf("colors of the game: %s, or a .toml or .json theme file", strings.Join(theme.Names(), ", ")))
+	flag.StringVar(&colors, "colors", "auto", "colors the terminal can show: none, 16 or 256 (default worked out from NO_COLOR, COLORTERM and TERM)")
+	flag.StringVar(&tiles, "tiles", "auto", "how letters are drawn: boxed, filled or compact tiles, or auto for the biggest that fit the terminal")
+	flag.BoolVar(&noAnimation, "no-animation", false, "don't animate revealing guesses, invalid words and wins. Animations are always off when stdout isn't a terminal.")
+	flag.StringVar(&sharePath, "share", "", "after quitting, write the share grid of the last finished game to this file, or to stdout if it's -")
+	flag.BoolVar(&headless, "headless", false, "play one game without the gui, reading guesses from stdin and writing feedback like GYBBG to stdout. The exit code is 0 if the game was won, 1 if it was lost and 3 if stdin ended first.")
+	flag.BoolVar(&asJSON, "json", false, "with -headless, write JSON lines instead of text")
 	flag.Parse()
 
-	currWordle = wordle.New()
+	set := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	seeded := set["seed"]
+	if !seeded {
+		s.practiceSeed = rand.New(rand.NewSource(time.Now().UnixNano())).Int63n(MAX_SEED)
+	}
+	if seeded && date != "" {
+		fmt.Fprintln(os.Stderr, "-date can't be used with -seed")
+		os.Exit(2)
+	}
+	if absurd && date != "" {
+		fmt.Fprintln(os.Stderr, "-date can't be used with -absurd")
+		os.Exit(2)
+	}
+	practice = practice || seeded || absurd
 
-	g, err := gocui.NewGui(gocui.OutputNormal)
-	g.Cursor = true
+	depth := theme.Detect(os.Getenv)
+	if colors != "auto" {
+		d, err := theme.ParseDepth(colors)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "-colors must be auto, none, 16 or 256")
+			os.Exit(2)
+		}
+		if d == theme.TrueColor {
+			fmt.Fprintln(os.Stderr, "-colors truecolor isn't supported, the board is drawn with at most 256 colors")
+			os.Exit(2)
+		}
+		depth = d
+	}
+	// without colors the tiles can only be told apart by their style
+	if depth == theme.NoColor && !set["theme"] {
+		themeName = "monochrome"
+	}
+	t, err := theme.Find(themeName)
 	if err != nil {
-		log.Panicln(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	// gocui can't draw 24-bit colors, so terminals detected as having them
+	// get the 256 color palette
+	if depth > theme.Palette {
+		depth = theme.Palette
+	}
+	s.colors = t.Escapes(depth)
+	if s.tiles, s.autoTiles, err = parseTileMode(tiles); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	s.animations = !noAnimation && isTerminal(os.Stdout)
+	if highContrast || t.Contrast {
+		s.sharePalette = wordle.HighContrast
 	}
-	defer g.Close()
 
-	g.SetManagerFunc(layout)
+	if !wordle.ValidLength(length) {
+		fmt.Fprintf(os.Stderr, "-length must be between %d and %d\n", wordle.MinLength, wordle.MaxLength)
+		os.Exit(2)
+	}
+	s.options = append(s.options, wordle.WithLength(length))
 
-	if err := keybindings(g); err != nil {
-		log.Panicln(err)
+	// adversarial games are about how many guesses it takes, so they don't
+	// end unless told to
+	if absurd && !set["tries"] {
+		tries = 0
+	}
+	if tries < 0 {
+		fmt.Fprintln(os.Stderr, "-tries can't be negative")
+		os.Exit(2)
+	} else if tries == 0 {
+		tries = wordle.Unlimited
+	}
+	// games with more boards get more tries, unless told otherwise
+	if set["tries"] || s.boards == 1 {
+		s.options = append(s.options, wordle.WithTries(tries))
 	}
 
-	if err := g.MainLoop(); err != nil && err != gocui.ErrQuit {
-		log.Panicln(err)
+	if s.boards < 1 || s.boards > MAX_BOARDS {
+		fmt.Fprintf(os.Stderr, "-boards must be between 1 and %d\n", MAX_BOARDS)
+		os.Exit(2)
 	}
-}
 
-func layout(g *gocui.Gui) error {
-	// initialize y position variables for views
-	startTitleY, endTitleY := 0, 2
-	startDescriptionY, endDescriptionY := endTitleY, endTitleY+2
-	startInputY, endInputY := endDescriptionY+2, endDescriptionY+17
-	startKeyboardY := endInputY + 1
-	endKeyboardY := startKeyboardY + 6
+	if absurd {
+		if s.boards > 1 {
+			fmt.Fprintln(os.Stderr, "-absurd can't be used with -boards")
+			os.Exit(2)
+		}
+		s.options = append(s.options, wordle.WithAdversary())
+	}
 
-	maxX, maxY := g.Size()
-	if maxY < endKeyboardY && !forcedLayout {
-		fmt.Println("Your terminal height is too small to play Wordle.")
-		fmt.Println("Try increasing the height and try again!")
-		fmt.Println("Or, run with the -f flag to force a play session.")
-		os.Exit(0)
+	if lies < 0 || lies > length {
+		fmt.Fprintf(os.Stderr, "-lies must be between 0 and %d\n", length)
+		os.Exit(2)
+	} else if lies > 0 {
+		if s.boards > 1 || absurd || hard || ultraHard {
+			fmt.Fprintln(os.Stderr, "-lies can't be used with -boards, -absurd, -hard or -ultra")
+			os.Exit(2)
+		}
+		s.options = append(s.options, wordle.WithLies(lies))
 	}
 
-	title := "Wordle"
+	if ultraHard {
+		s.options = append(s.options, wordle.WithDifficulty(wordle.UltraHard))
+	} else if hard {
+		s.options = append(s.options, wordle.WithDifficulty(wordle.Hard))
+	}
 
-	if v, err := g.SetView("title", maxX/2-len(title)/2, startTitleY, maxX/2+len(title), endTitleY); err != nil {
-		if err != gocui.ErrUnknownView {
-			return err
+	if !practice {
+		puzzle, err := puzzleForDate(date, epoch, timezone)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
 		}
-		v.Frame = false
-		fmt.Fprintln(v, title)
+		s.dailyPuzzle = puzzle
+	} else if date != "" {
+		fmt.Fprintln(os.Stderr, "-date can't be used with -practice")
+		os.Exit(2)
 	}
-	description := "Guess the Hidden Word!"
 
-	if v, err := g.SetView("description", maxX/2-len(description)/2, startDescriptionY, maxX+len(description)/2, endDescriptionY); err != nil {
-		if err != gocui.ErrUnknownView {
-			return err
-		}
-		v.Frame = false
-		fmt.Fprintln(v, description)
+	// headless games are played on their own, without statistics or saving
+	if headless {
+		os.Exit(playHeadless(s.newGame(), os.Stdin, os.Stdout, asJSON))
 	}
 
-	if v, err := g.SetView("input", maxX/2-11, startInputY, maxX/2+11, endInputY); err != nil {
-		if err != gocui.ErrUnknownView {
-			return err
-		}
-		v.Editable = true
-		if _, err := g.SetCurrentView("input"); err != nil {
-			return err
+	if statsPath == "" {
+		path, err := store.StatsPath()
+		if err != nil {
+			log.Panicln(err)
 		}
-		writeBlankLines(v)
-		v.SetCursor(WORD_START, 0)
+		statsPath = path
+	}
+	if s.stats, err = store.OpenStats(statsPath); err != nil {
+		fmt.Fprintf(os.Stderr, "couldn't load statistics from %s: %v\n", statsPath, err)
+		os.Exit(1)
 	}
 
-	if v, err := g.SetView("keyboard", maxX/2-len(KEYBOARD_START)/2-1, startKeyboardY, maxX/2+len(KEYBOARD_START)/2+1, endKeyboardY); err != nil {
-		if err != gocui.ErrUnknownView {
-			return err
+	if s.savePath, err = store.SavePath(); err != nil {
+		log.Panicln(err)
+	}
+	if startNew {
+		if err := store.ClearGame(s.savePath); err != nil {
+			log.Panicln(err)
 		}
-		v.Frame = false
-		printKeyboard(v)
 	}
 
-	return nil
-}
-
-func printKeyboard(v *gocui.View) {
-	fmt.Fprint(v, strings.Join(COLORED_KEYBOARD[:], "  "))
-}
+	// a daily puzzle that was already played is shown as it ended, and
+	// otherwise a saved game is resumed unless a specific game was asked for
+	replayed := s.replayDaily()
+	switch {
+	case replayed:
+	case startNew || date != "" || seeded || !s.resumeGame():
+		s.game = s.newGame()
+	case s.game.Mode() == wordle.Daily && s.game.Puzzle() == s.dailyPuzzle:
+		s.dailyPuzzle = -1
+	}
 
-func colorLetters(guess string, target string) string {
-	target_map := make(map[rune]int)
-	for _, char := range target {
-		target_map[char] += 1
+	mode := gocui.OutputNormal
+	if depth == theme.Palette {
+		mode = gocui.Output256
+	}
+	g, err := gocui.NewGui(mode)
+	if err != nil {
+		log.Panicln(err)
 	}
+	defer g.Close()
 
-	color_array := make([]string, WORD_LEN)
+	g.SetManagerFunc(s.layout)
+	warmUpSolver(s.game.Length())
 
-	guess_runes := []rune(guess)
-	target_runes := []rune(target)
+	if err := s.keybindings(g); err != nil {
+		log.Panicln(err)
+	}
+	if replayed {
+		// opened once the first layout has made the views it's placed by
+		g.Update(func(g *gocui.Gui) error {
+			return s.toggleStats(g, nil)
+		})
+	}
 
-	// get green letters first
-	for index, char := range guess_runes {
-		if index >= len(target_runes) {
-			break
-		}
+	if err := g.MainLoop(); err != nil && err != gocui.ErrQuit {
+		log.Panicln(err)
+	}
 
-		if target_runes[index] == char {
-			color_array[index] = GREEN + string(char)
-			target_map[char]-- // we 'move' the char from target to color array
+	if sharePath != "" {
+		// the gui has to be closed first so the grid isn't drawn over
+		g.Close()
+		if err := s.writeShare(sharePath); err != nil {
+			fmt.Fprintf(os.Stderr, "couldn't write share grid: %v\n", err)
+			os.Exit(1)
 		}
-
-		// update keyboard to show this character as green
-		updateCharInKeyboard(char, GREEN)
 	}
+}
 
-	// get remaining yellow and white letters now
-	for index, char := range guess_runes {
-		if index >= len(target_runes) {
-			break
+// redraws every board and the keyboard from the state of the game
+func (s *Session) redraw(g *gocui.Gui) error {
+	for i, board := range s.game.Boards() {
+		v, err := g.View(boardName(i))
+		if err != nil {
+			return err
 		}
+		s.drawBoard(v, board)
+		s.placeCursor(v, board)
+	}
 
-		if target_runes[index] == char {
-			continue // we finished the greens
-		} else if target_map[char] > 0 {
-			// then make this rune yellow
-			color_array[index] = YELLOW + string(char)
-			// decrement the value in map
-			target_map[char]--
-
-			// update keyboard to show this character as yellow
-			updateCharInKeyboard(char, YELLOW)
-		} else {
-			// then make this rune white
-			color_array[index] = RESET + string(char)
-
-			// update keyboard to show this character as gray
-			updateCharInKeyboard(char, GRAY)
+	if s.boards > 1 {
+		v, err := g.View("messages")
+		if err != nil {
+			return err
+		}
+		v.Clear()
+		if !s.revealing() {
+			s.printResult(v)
 		}
 	}
 
-	// join all the strings with RESET at end to make sure future text is white
-	return SPACE + strings.Join(color_array, "") + RESET
-}
-
-// changes color of the specified character in the visual keyboard
-func updateCharInKeyboard(char rune, color string) {
-	original_char_str := KEYBOARD[KEYBOARD_POSITIONS[char-'a']]
-	COLORED_KEYBOARD[KEYBOARD_POSITIONS[char-'a']] = color + original_char_str + RESET
+	// the keyboard is left out when the terminal is too small for it
+	if v, err := g.View("keyboard"); err == nil {
+		v.Clear()
+		s.printKeyboard(v)
+	}
+	return nil
 }
 
-func updateKeyboard(v *gocui.View) {
+// draws a board: the guesses it got, colored, then the row being typed in and
+// a blank row for each try left while it is still being played. Solved boards
+// stop there, and boards that weren't solved show their target once the game
+// is lost. A single board also shows the end of game messages, once the
+// last guess has been revealed.
+func (s *Session) drawBoard(v *gocui.View, board *wordle.Wordle) {
 	v.Clear()
-	printKeyboard(v)
+	for i, row := range board.Rows() {
+		s.drawTiles(v, s.animateTiles(s.guessTiles(row, i), i), 0)
+	}
+
+	if !board.Over() {
+		style := ""
+		if s.enteredGibberish {
+			style = s.colors.Error
+		}
+		s.drawTiles(v, s.wordTiles(s.typed, style), s.shakeOffset())
+		if board.Tries() != wordle.Unlimited {
+			for i := board.Guesses() + 1; i < board.Tries(); i++ {
+				s.drawTiles(v, s.wordTiles("", ""), 0)
+			}
+		}
+	} else if s.revealing() {
+		return
+	} else if s.boards > 1 {
+		if board.State() == wordle.Lost {
+			s.drawTiles(v, s.wordTiles(board.Target(), s.colors.Error), 0)
+		}
+	} else {
+		fmt.Fprintln(v)
+		s.printResult(v)
+	}
 }
 
-func submitGuess(g *gocui.Gui, v *gocui.View) error {
-	untrimmedGuess, err := v.Line(currWordle.Guesses)
-	guess := strings.Trim(untrimmedGuess, " _")
+// prints whether the game was won or lost and what can be done next, once
+// it's over. The messages are centered in the view.
+func (s *Session) printResult(v *gocui.View) {
+	width, _ := v.Size()
+	indent := ""
+	if width > INPUT_WIDTH-1 {
+		indent = strings.Repeat(" ", (width-INPUT_WIDTH+1)/2)
+	}
+	switch s.game.State() {
+	case wordle.Won:
+		fmt.Fprintf(v, "%s       %sYou won!%s\n", indent, s.colors.Success, RESET)
+		s.outputDirections(v, indent)
+	case wordle.Lost:
+		fmt.Fprintf(v, "%s      %sYou lost!%s\n", indent, s.colors.Error, RESET)
+		// the targets of a grid of boards are shown on the boards
+		if s.boards == 1 {
+			target := s.game.Boards()[0].Target()
+			fmt.Fprintf(v, "%sThe correct word was:\n", indent)
+			fmt.Fprintf(v, "%s%s\n", strings.Repeat(" ", (width-len(target))/2), target)
+		}
+		s.outputDirections(v, indent)
+	}
+}
 
-	if err != nil {
-		return err
+func (s *Session) submitGuess(g *gocui.Gui, v *gocui.View) error {
+	taken, err := s.submit()
+	// if this isn't a real word, the guess wasn't submitted
+	if !taken {
+		if !s.game.Over() {
+			s.animate(g, shakeAnimation, 0)
+		}
+		return s.redraw(g)
 	}
-	// if this isn't a real word, don't submit the guess
-	if len(guess) != WORD_LEN || currWordle.EnteredGibberish {
-		return nil
-	}
-
-	/* buffer lines will be like this: (in this ex for guess #0)
-	 * [`guess`, _____, ...., _____]
-	 * So, we want to save every line after `guess`.
-	 * In general, for the current guess # k, we want to skip
-	 * over line k in the buffer. So, we will use all saved lines
-	 * before the guess and save all lines after the guess line.
-	 * By saving those, we can clear the buffer. Then, we will
-	 * print what was before, then the newly colored guess word,
-	 * then we will reprint what was after
-	 */
-	bufferLinesBefore := currWordle.PreviousGuesses
-	bufferLinesAfter := v.ViewBufferLines()[currWordle.Guesses+1:]
-
-	v.Clear() // clear buffer
-
-	// reprint the lines before the guess
-	for i := range bufferLinesBefore {
-		fmt.Fprintln(v, bufferLinesBefore[i])
-	}
-	// print newly made guess with colors
-	colored_guess := colorLetters(guess, currWordle.Target)
-	currWordle.PreviousGuesses = append(currWordle.PreviousGuesses, colored_guess)
-	fmt.Fprintln(v, colored_guess)
-	// reprint the lines after the guess
-	for i := range bufferLinesAfter {
-		fmt.Fprintln(v, bufferLinesAfter[i])
-	}
-
-	currWordle.Guesses++
-	// put cursor at start of next line
-	v.SetCursor(WORD_START, currWordle.Guesses)
-
-	if guess == currWordle.Target {
-		finishGame(v)
-		fmt.Fprintf(v, "       %sYou won!%s\n", BLUE, RESET)
-		outputDirections(v)
-
-		// fmt.Fprintln(v, "Press Ctrl+C to quit.")
-	} else if currWordle.Guesses == NUM_TRIES {
-		// then we lost
-		finishGame(v)
-		fmt.Fprintf(v, "      %sYou lost!%s\n", RED, RESET)
-		fmt.Fprintln(v, "The correct word was:")
-		fmt.Fprintf(v, "%s%s\n", SPACE, currWordle.Target)
-		outputDirections(v)
-	}
-	// update keyboard
-	keyboard_view, err := g.View("keyboard")
+	s.animate(g, revealAnimation, s.game.Guesses()-1)
 	if err != nil {
-		log.Panic("No view named keyboard")
+		s.setStatus(g, err.Error())
 	}
-	updateKeyboard(keyboard_view)
-
-	g.Update(layout)
-	return nil
+	closeHint(g)
+	if s.game.Over() {
+		s.stopMarking(g, v)
+	}
+	return s.redraw(g)
 }
 
-func turnRed(g *gocui.Gui, v *gocui.View) {
-	currWord, err := v.Line(currWordle.Guesses)
-	if err != nil {
-		log.Panic("Couldn't read line")
+// submits the typed guess and reports whether the game took it. The game is
+// then saved, and recorded in the statistics once it's over. The error says
+// what couldn't be saved.
+func (s *Session) submit() (bool, error) {
+	if _, err := s.game.Guess(s.typed); err != nil {
+		return false, nil
 	}
-	bufferLinesAfter := v.ViewBufferLines()[currWordle.Guesses+1:]
+	s.typed = ""
 
-	v.Clear()
-	// reprint the lines before the guess
-	for i := range currWordle.PreviousGuesses {
-		fmt.Fprintln(v, currWordle.PreviousGuesses[i])
+	var err error
+	if s.saveGame() != nil {
+		err = errors.New("Couldn't save the game")
 	}
-	fmt.Fprintf(v, "%s%s%s\n", RED, currWord, RESET)
-	// reprint the lines after the guess
-	for i := range bufferLinesAfter {
-		fmt.Fprintln(v, bufferLinesAfter[i])
+	if s.game.Over() && s.recordResult() != nil {
+		err = errors.New("Couldn't save statistics")
 	}
-	currWordle.EnteredGibberish = true
+	return true, err
 }
 
-func turnWhite(g *gocui.Gui, v *gocui.View) {
-	// then clear the buffer, print everything before the word,
-	// print the word in white, then print everything after word
-	currWord, err := v.Line(currWordle.Guesses)
+// shows an error message centered under the input view, or clears it if msg
+// is empty
+func (s *Session) setStatus(g *gocui.Gui, msg string) {
+	setColoredStatus(g, s.colors.Error, msg)
+}
+
+// shows a message centered under the input view in the given color
+func setColoredStatus(g *gocui.Gui, color string, msg string) {
+	v, err := g.View("status")
 	if err != nil {
-		log.Panic("Couldn't read line")
+		log.Panic("No view named status")
 	}
-	bufferLinesAfter := v.ViewBufferLines()[currWordle.Guesses+1:]
-
 	v.Clear()
-	// reprint the lines before the guess
-	for i := range currWordle.PreviousGuesses {
-		fmt.Fprintln(v, currWordle.PreviousGuesses[i])
-	}
-	fmt.Fprintln(v, currWord)
-	// reprint the lines after the guess
-	for i := range bufferLinesAfter {
-		fmt.Fprintln(v, bufferLinesAfter[i])
+	width, _ := v.Size()
+	if pad := (width - len(msg)) / 2; pad > 0 {
+		msg = strings.Repeat(" ", pad) + msg
 	}
-	currWordle.EnteredGibberish = false
+	fmt.Fprintf(v, "%s%s%s", color, msg, RESET)
 }
 
-func finishGame(v *gocui.View) {
-	v.Clear()
-	// reprint the lines before the guess
-	for i := range currWordle.PreviousGuesses {
-		fmt.Fprintln(v, currWordle.PreviousGuesses[i])
-	}
+func (s *Session) outputDirections(v *gocui.View, indent string) {
 	fmt.Fprintln(v)
-	currWordle.GameOver = true
+	fmt.Fprintf(v, "%sPlay Again: %sspace bar%s\n", indent, s.colors.Accent, RESET)
+	fmt.Fprintf(v, "%s Statistics: %s^T%s\n", indent, s.colors.Accent, RESET)
+	fmt.Fprintf(v, "%s      Share: %s^Y%s\n", indent, s.colors.Accent, RESET)
+	fmt.Fprintf(v, "%s       Quit: %s^C%s\n", indent, s.colors.Accent, RESET)
 }
 
-func outputDirections(v *gocui.View) {
-	fmt.Fprintln(v)
-	fmt.Fprintf(v, "Play Again: %sspace bar%s\n", CYAN, RESET)
-	fmt.Fprintf(v, "       Quit: %s^C%s\n", CYAN, RESET)
+func (s *Session) handleBackspace(g *gocui.Gui, v *gocui.View) error {
+	s.stopAnimation()
+	if s.deleteLetter() {
+		s.setStatus(g, "")
+	}
+	return s.redraw(g)
 }
 
-func handleBackspace(g *gocui.Gui, v *gocui.View) error {
-	x, y := v.Cursor()
-	if x > WORD_START {
-		v.EditDelete(true) // delete character to left
-		v.EditWrite('_')
-		v.SetCursor(x-1, y)
-	}
-	if currWordle.EnteredGibberish {
-		// then clear the buffer, print everything before the word,
-		// print the word in white, then print everything after word
-		turnWhite(g, v)
+// removes the last letter typed, and reports whether that took back the
+// mark of a complete guess that would be rejected
+func (s *Session) deleteLetter() bool {
+	if len(s.typed) > 0 {
+		s.typed = s.typed[:len(s.typed)-1]
 	}
-	return nil
+	// the word is no longer complete, so it's no longer wrong either
+	wasGibberish := s.enteredGibberish
+	s.enteredGibberish = false
+	return wasGibberish
 }
 
-func handleCharacter(char rune) func(g *gocui.Gui, v *gocui.View) error {
+func (s *Session) handleCharacter(char rune) func(g *gocui.Gui, v *gocui.View) error {
 	return func(g *gocui.Gui, v *gocui.View) error {
-		if currWordle.GameOver {
+		if v.Name() != "input" {
 			return nil
 		}
-		x, y := v.Cursor()
-		if x < WORD_START+WORD_LEN {
-			// delete the underscore to the right
-			v.EditDelete(false)
-			v.EditWrite(unicode.ToLower(char))
-			v.SetCursor(x+1, y)
-
-			untrimmedGuess, err := v.Line(currWordle.Guesses)
-			if err != nil {
-				log.Panic()
-			}
-			guess := strings.Trim(untrimmedGuess, " _")
-
-			// if this isn't a real word, turn word red
-			if len(guess) == WORD_LEN && !wordle.LegalWords[guess] {
-				turnRed(g, v)
-			}
+		typed, err := s.typeLetter(char)
+		if !typed {
+			return nil
+		}
+		s.stopAnimation()
+		// if this isn't a real word or breaks a hard mode rule, turn word red
+		if err != nil {
+			s.setStatus(g, err.Error())
+			s.animate(g, shakeAnimation, 0)
 		}
-		return nil
+		return s.redraw(g)
 	}
 }
 
+// adds a letter to the guess being typed if there's room for it, and reports
+// whether there was. Once the guess is complete, the error says why the game
+// would reject it.
+func (s *Session) typeLetter(char rune) (bool, error) {
+	if s.game.Over() || len(s.typed) == s.wordLen() {
+		return false, nil
+	}
+	s.typed += string(unicode.ToLower(char))
+	if len(s.typed) < s.wordLen() {
+		return true, nil
+	}
+	err := s.game.Check(s.typed)
+	s.enteredGibberish = err != nil
+	return true, err
+}
+
 func handleShift(g *gocui.Gui, v *gocui.View) error {
 	fmt.Fprintln(v, v.ViewBuffer())
 	return nil
@@ -345,68 +475,172 @@ func doNothing(g *gocui.Gui, v *gocui.View) error {
 	return nil
 }
 
-func handleSpace(g *gocui.Gui, v *gocui.View) error {
-	if currWordle.GameOver {
+func (s *Session) handleSpace(g *gocui.Gui, v *gocui.View) error {
+	if s.marking {
+		return s.toggleMark(g)
+	}
+	if s.game.Over() {
 		// if game over, then space bar will restart the game
-		v.Clear()
-		currWordle = wordle.New()
-		writeBlankLines(v)
+		s.stopAnimation()
+		s.restart()
+		return s.redraw(g)
+	}
+	return nil // else do nothing
+}
 
-		// update keyboard
-		keyboard_view, err := g.View("keyboard")
-		if err != nil {
-			log.Panic("No view named keyboard")
+// starts the next game, forgetting everything typed and marked in the last
+func (s *Session) restart() {
+	s.game = s.newGame()
+	s.enteredGibberish = false
+	s.typed = ""
+	s.marks = nil
+}
+
+// starts the pending daily puzzle if there is one, otherwise a practice game
+// with the next seed
+func (s *Session) newGame() *wordle.Game {
+	opts := s.options[:len(s.options):len(s.options)]
+	if s.dailyPuzzle >= 0 {
+		opts = append(opts, wordle.WithPuzzle(s.dailyPuzzle))
+		s.dailyPuzzle = -1
+	} else {
+		opts = append(opts, wordle.WithSeed(s.practiceSeed))
+		s.practiceSeed = rand.New(rand.NewSource(s.practiceSeed)).Int63n(MAX_SEED)
+	}
+	return wordle.NewGame(s.boards, opts...)
+}
+
+// works out which daily puzzle to play. An empty date means today.
+func puzzleForDate(date string, epoch string, timezone string) (int, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timezone %q: %v", timezone, err)
+	}
+	first, err := time.Parse(DATE_FORMAT, epoch)
+	if err != nil {
+		return 0, fmt.Errorf("invalid epoch %q, expected YYYY-MM-DD", epoch)
+	}
+
+	day := time.Now()
+	if date != "" {
+		if day, err = time.ParseInLocation(DATE_FORMAT, date, loc); err != nil {
+			return 0, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
 		}
-		initKeyboard()
-		updateKeyboard(keyboard_view)
+	}
+	puzzle := wordle.PuzzleNumber(day, first, loc)
+	if puzzle < 0 {
+		return 0, fmt.Errorf("there is no puzzle before %s", epoch)
+	}
+	return puzzle, nil
+}
+
+// number of letters in the words of the current game
+func (s *Session) wordLen() int {
+	return s.game.Length()
+}
 
-		v.SetCursor(WORD_START, 0)
-		g.Update(layout)
+// column the words on a board start at, which centers them in the input view
+// or leaves a space on both sides of the smaller boards of a grid
+func (s *Session) wordStart() int {
+	if s.boards > 1 {
+		return 1
 	}
-	return nil // else do nothing
+	return (s.inputWidth() - 1 - s.wordWidth()) / 2
 }
 
-func writeBlankLines(v *gocui.View) {
-	for i := 0; i < 6; i++ {
-		fmt.Fprintf(v, "%s_____\n", SPACE)
+// number of rows of a board visible at once: one per try, or a window that
+// scrolls along with the guesses when tries are unlimited
+func (s *Session) boardRows() int {
+	if s.game.Tries() == wordle.Unlimited {
+		return UNLIMITED_ROWS
 	}
+	return s.game.Tries()
+}
+
+// scrolls a board so the row being typed in, or its last guess once it's
+// over, is visible and puts the cursor where the next letter goes
+func (s *Session) placeCursor(v *gocui.View, board *wordle.Wordle) {
+	last := board.Guesses()
+	if board.Over() {
+		last--
+	}
+	originRow := last + 1 - s.boardRows()
+	if originRow < 0 {
+		originRow = 0
+	}
+	// rows of tiles can be more than a line high, and the cursor goes in the
+	// middle of the tile, which is on the last line of a filled tile
+	height, step := s.tiles.height(), s.tiles.step()
+	v.SetOrigin(0, originRow*height)
+	v.SetCursor(s.wordStart()+len(s.typed)*step+(step-1)/2, (board.Guesses()-originRow)*height+height/2)
 }
 
-func quit(g *gocui.Gui, v *gocui.View) error {
+func (s *Session) quit(g *gocui.Gui, v *gocui.View) error {
+	s.stopAnimation()
+	if err := s.saveGame(); err != nil {
+		s.setStatus(g, "Couldn't save the game")
+	}
 	return gocui.ErrQuit
 }
 
-func keybindings(g *gocui.Gui) error {
-	if err := g.SetKeybinding("input", gocui.KeyEnter, gocui.ModNone, submitGuess); err != nil {
+func (s *Session) keybindings(g *gocui.Gui) error {
+	if err := g.SetKeybinding("input", gocui.KeyEnter, gocui.ModNone, s.submitGuess); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+	if err := g.SetKeybinding("", gocui.KeyCtrlC, gocui.ModNone, s.quit); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding("input", gocui.KeyBackspace, gocui.ModNone, handleBackspace); err != nil {
+	if err := g.SetKeybinding("input", gocui.KeyBackspace, gocui.ModNone, s.handleBackspace); err != nil {
 		return err
 	}
-	if err := g.SetKeybinding("input", gocui.KeyBackspace2, gocui.ModNone, handleBackspace); err != nil {
+	if err := g.SetKeybinding("input", gocui.KeyBackspace2, gocui.ModNone, s.handleBackspace); err != nil {
 		return err
 	}
 	for _, c := range "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ" {
-		if err := g.SetKeybinding("", c, gocui.ModNone, handleCharacter(c)); err != nil {
+		if err := g.SetKeybinding("", c, gocui.ModNone, s.handleCharacter(c)); err != nil {
 			return err
 		}
 	}
-	if err := g.SetKeybinding("input", gocui.KeySpace, gocui.ModNone, handleSpace); err != nil {
+	if err := g.SetKeybinding("input", gocui.KeySpace, gocui.ModNone, s.handleSpace); err != nil {
 		return err
 	}
-	for _, key := range []interface{}{gocui.KeyDelete, gocui.KeyArrowDown, gocui.KeyArrowUp, gocui.KeyArrowLeft, gocui.KeyArrowRight} {
-		if err := g.SetKeybinding("input", key, gocui.ModNone, doNothing); err != nil {
+	if err := g.SetKeybinding("input", gocui.KeyDelete, gocui.ModNone, doNothing); err != nil {
+		return err
+	}
+	// the arrow keys only do something while marking suspected lies
+	for key, move := range map[gocui.Key][2]int{
+		gocui.KeyArrowLeft:  {-1, 0},
+		gocui.KeyArrowRight: {1, 0},
+		gocui.KeyArrowUp:    {0, -1},
+		gocui.KeyArrowDown:  {0, 1},
+	} {
+		if err := g.SetKeybinding("input", key, gocui.ModNone, s.moveMark(move[0], move[1])); err != nil {
 			return err
 		}
 	}
-	for _, c := range "1234567890~!@#$%^&*()-_+=[]\\{}|;':\",./<>?" {
+	if err := g.SetKeybinding("input", gocui.KeyTab, gocui.ModNone, s.toggleMarking); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("input", gocui.KeyEsc, gocui.ModNone, s.stopMarking); err != nil {
+		return err
+	}
+	for _, c := range "1234567890~!@#$%^&*()-_+=[]\\{}|;':\",./<>" {
 		if err := g.SetKeybinding("", c, gocui.ModNone, doNothing); err != nil {
 			return err
 		}
 	}
+	if err := g.SetKeybinding("", '?', gocui.ModNone, s.toggleHint); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("", gocui.KeyCtrlT, gocui.ModNone, s.toggleStats); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("", gocui.KeyCtrlY, gocui.ModNone, s.copyShare); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding("stats", gocui.KeyEsc, gocui.ModNone, closeStats); err != nil {
+		return err
+	}
 	if err := g.SetKeybinding("input", gocui.KeyCtrlSpace, gocui.ModNone, handleShift); err != nil {
 		return err
 	}
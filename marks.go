@@ -0,0 +1,53 @@
+package main
+
+import (
+	"github.com/jroimartin/gocui"
+)
+
+// starts or stops marking tiles in a game that lies
+func (s *Session) toggleMarking(g *gocui.Gui, v *gocui.View) error {
+	if s.marking {
+		return s.stopMarking(g, v)
+	}
+	if s.game.Lies() == 0 || s.game.Over() || s.game.Guesses() == 0 {
+		return nil
+	}
+	s.marking = true
+	s.markRow, s.markCol = s.game.Guesses()-1, 0
+	setColoredStatus(g, s.colors.Accent, "Arrows: move  Space: mark  Tab: done")
+	return s.redraw(g)
+}
+
+func (s *Session) stopMarking(g *gocui.Gui, v *gocui.View) error {
+	if !s.marking {
+		return nil
+	}
+	s.marking = false
+	s.setStatus(g, "")
+	return s.redraw(g)
+}
+
+// moves between the tiles of the submitted guesses while marking
+func (s *Session) moveMark(dx int, dy int) func(g *gocui.Gui, v *gocui.View) error {
+	return func(g *gocui.Gui, v *gocui.View) error {
+		if !s.marking {
+			return nil
+		}
+		if row := s.markRow + dy; row >= 0 && row < s.game.Guesses() {
+			s.markRow = row
+		}
+		if col := s.markCol + dx; col >= 0 && col < s.wordLen() {
+			s.markCol = col
+		}
+		return s.redraw(g)
+	}
+}
+
+// marks the tile being marked as a suspected lie, or unmarks it
+func (s *Session) toggleMark(g *gocui.Gui) error {
+	for len(s.marks) <= s.markRow {
+		s.marks = append(s.marks, make([]bool, s.wordLen()))
+	}
+	s.marks[s.markRow][s.markCol] = !s.marks[s.markRow][s.markCol]
+	return s.redraw(g)
+}
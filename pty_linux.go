@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// ptyTerminal is a pseudo terminal. The game runs on the slave side while
+// the ssh session reads and writes the master side.
+type ptyTerminal struct {
+	master, slave *os.File
+	// value of TERM the client asked for
+	name string
+}
+
+func openPTY() (*ptyTerminal, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		return nil, err
+	}
+	fd := int(master.Fd())
+	if err := unix.IoctlSetPointerInt(fd, unix.TIOCSPTLCK, 0); err != nil {
+		master.Close()
+		return nil, fmt.Errorf("unlocking pty: %w", err)
+	}
+	n, err := unix.IoctlGetInt(fd, unix.TIOCGPTN)
+	if err != nil {
+		master.Close()
+		return nil, fmt.Errorf("finding pty: %w", err)
+	}
+	slave, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", n), os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, err
+	}
+	return &ptyTerminal{master: master, slave: slave, name: "xterm"}, nil
+}
+
+// Resize tells the game the terminal is now columns wide and rows high
+func (t *ptyTerminal) Resize(columns, rows int) error {
+	return unix.IoctlSetWinsize(int(t.master.Fd()), unix.TIOCSWINSZ, &unix.Winsize{
+		Col: uint16(columns),
+		Row: uint16(rows),
+	})
+}
+
+func (t *ptyTerminal) Close() {
+	t.master.Close()
+	t.slave.Close()
+}
+
+// startGame runs the game in a new session with the terminal as its
+// controlling terminal, keeping its files in dataDir
+func (s *sshServer) startGame(term *ptyTerminal, dataDir string) (*exec.Cmd, error) {
+	if err := os.MkdirAll(dataDir, 0o755); err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(s.self, s.gameArgs...)
+	cmd.Env = []string{
+		"TERM=" + term.name,
+		"XDG_DATA_HOME=" + dataDir,
+	}
+	if tz, ok := os.LookupEnv("TZ"); ok {
+		cmd.Env = append(cmd.Env, "TZ="+tz)
+	}
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = term.slave, term.slave, term.slave
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	// the game holds its own copy, and the master only sees the end of its
+	// output once every copy is closed
+	term.slave.Close()
+	return cmd, nil
+}
@@ -0,0 +1,28 @@
+//go:build !linux
+
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+type ptyTerminal struct {
+	master, slave *os.File
+	name          string
+}
+
+func openPTY() (*ptyTerminal, error) {
+	return nil, errors.New("wordle ssh needs pseudo terminals, which are only supported on linux")
+}
+
+func (t *ptyTerminal) Resize(columns, rows int) error {
+	return nil
+}
+
+func (t *ptyTerminal) Close() {}
+
+func (s *sshServer) startGame(term *ptyTerminal, dataDir string) (*exec.Cmd, error) {
+	return nil, errors.New("wordle ssh is only supported on linux")
+}
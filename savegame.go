@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/store"
+)
+
+// loads the game saved by a previous session, if it was started with the
+// settings asked for on the command line: the same length, boards, tries,
+// difficulty and lies, and daily, practice or adversarial like the game that
+// would be started otherwise. A saved game with other settings is left to be
+// replaced by a new one.
+func (s *Session) resumeGame() bool {
+	saved, err := store.LoadGame(s.savePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ignoring saved game that couldn't be restored: %v\n", err)
+		return false
+	}
+	if saved == nil {
+		return false
+	}
+
+	// a game with the same options, without the puzzle or seed picked by
+	// newGame
+	want := wordle.NewGame(s.boards, s.options...)
+	mode := want.Mode()
+	if s.dailyPuzzle >= 0 {
+		mode = wordle.Daily
+	}
+	if saved.Length() != want.Length() || len(saved.Boards()) != len(want.Boards()) ||
+		saved.Tries() != want.Tries() || saved.Difficulty() != want.Difficulty() ||
+		saved.Lies() != want.Lies() || saved.Mode() != mode {
+		return false
+	}
+
+	s.game = saved
+	return true
+}
+
+// shows the pending daily puzzle as it ended if it's already in the statistics,
+// so it isn't played and recorded again. Reports whether it was.
+func (s *Session) replayDaily() bool {
+	if s.dailyPuzzle < 0 {
+		return false
+	}
+	opts := append(s.options[:len(s.options):len(s.options)], wordle.WithPuzzle(s.dailyPuzzle))
+	game := wordle.NewGame(s.boards, opts...)
+	record, ok := s.stats.Daily(s.dailyPuzzle, store.VariantOf(game))
+	if !ok {
+		return false
+	}
+	for _, word := range record.Words {
+		if _, err := game.Guess(word); err != nil {
+			break
+		}
+	}
+	s.game = game
+	s.dailyPuzzle = -1
+	return true
+}
+
+// saves the game in progress so it can be resumed, or removes the saved game
+// once it's over
+func (s *Session) saveGame() error {
+	if s.game.Over() {
+		return store.ClearGame(s.savePath)
+	}
+	return store.SaveGame(s.savePath, s.game)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/store"
+)
+
+func TestReplayDaily(t *testing.T) {
+	s := newTestSession(t, 1)
+	s.dailyPuzzle = 3
+	if s.replayDaily() {
+		t.Fatal("replayDaily replayed a puzzle that wasn't played")
+	}
+	s.game = s.newGame()
+	target := s.game.Boards()[0].Target()
+	for _, word := range []string{"abet", target} {
+		if _, err := play(s, word); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the same puzzle asked for again, like after relaunching on the same day
+	s.dailyPuzzle = 3
+	if !s.replayDaily() {
+		t.Fatal("replayDaily didn't replay a finished puzzle")
+	}
+	if s.game.Mode() != wordle.Daily || s.game.Puzzle() != 3 || s.game.State() != wordle.Won || s.game.Guesses() != 2 {
+		t.Errorf("replayed puzzle %d is %s after %d guesses, want puzzle 3 won in 2", s.game.Puzzle(), s.game.State(), s.game.Guesses())
+	}
+	if s.dailyPuzzle != -1 {
+		t.Errorf("daily puzzle %d is still pending after replaying it", s.dailyPuzzle)
+	}
+
+	// played through again, like a puzzle recorded before guesses were kept
+	s.dailyPuzzle = 3
+	s.game = s.newGame()
+	if _, err := play(s, target); err != nil {
+		t.Fatal(err)
+	}
+	if sum := s.stats.Summary(store.VariantOf(s.game)); sum.Played != 1 {
+		t.Errorf("statistics have %d games of one daily puzzle, want 1", sum.Played)
+	}
+
+	s.dailyPuzzle = 4
+	if s.replayDaily() {
+		t.Error("replayDaily replayed puzzle 4 after puzzle 3 was played")
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/x2dtu/wordle/wordle/server"
+)
+
+// runs "wordle serve": games are played over a JSON HTTP API, see the server
+// package
+func runServe(args []string) int {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := flags.String("addr", "localhost:8080", "address to listen on")
+	ttl := flags.Duration("ttl", 24*time.Hour, "how long games are kept after their last request")
+	flags.Parse(args)
+
+	if *ttl <= 0 {
+		fmt.Fprintln(os.Stderr, "-ttl must be positive")
+		return 2
+	}
+
+	log.Printf("serving games on http://%s", *addr)
+	if err := http.ListenAndServe(*addr, server.New(*ttl)); err != nil {
+		log.Println(err)
+		return 1
+	}
+	return 0
+}
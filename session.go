@@ -0,0 +1,72 @@
+package main
+
+import (
+	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/store"
+	"github.com/x2dtu/wordle/wordle/theme"
+)
+
+// Session is a game played in a gui and everything the gui keeps track of
+// between key presses. Its handlers are bound to the gui by keybindings, so
+// nothing is shared between sessions.
+type Session struct {
+	game             *wordle.Game
+	enteredGibberish bool
+	forcedLayout     bool
+
+	// letters typed for the next guess so far
+	typed string
+
+	// number of boards every game is played on
+	boards int
+
+	// options every new game is created with, built from the command line
+	// flags
+	options []wordle.Option
+
+	// number of the daily puzzle that still has to be played, or -1 if there
+	// is none. Once it is played, restarting switches to practice games.
+	dailyPuzzle int
+
+	// seed of the next practice game. Each practice game's seed is derived
+	// from the one before it, so a whole session can be replayed with -seed.
+	practiceSeed int64
+
+	// tiles of the submitted guesses the player marked as suspected lies, by
+	// row and then letter
+	marks [][]bool
+
+	// whether the arrow keys move between the tiles of the submitted guesses
+	// to mark them, and the tile they're on
+	marking          bool
+	markRow, markCol int
+
+	// statistics of every completed game
+	stats *store.Stats
+
+	// where the game in progress is saved
+	savePath string
+
+	// how the letters on the boards are drawn, and whether that's picked to
+	// fit the terminal
+	tiles     tileMode
+	autoTiles bool
+
+	// how much is shown around the boards, picked to fit the terminal
+	layoutMode layoutMode
+
+	// escape sequences of the theme the gui is drawn in
+	colors theme.Escapes
+
+	// whether guesses, invalid words and wins are animated, and the
+	// animation being played if there is one
+	animations bool
+	animation  *animation
+
+	// palette used for share grids
+	sharePalette wordle.Palette
+
+	// share grid of the last finished game, written out on exit if -share is
+	// given
+	lastShare string
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/solver"
+	"github.com/x2dtu/wordle/wordle/store"
+)
+
+// a session playing practice games, with its files in a directory of its own
+func newTestSession(t *testing.T, seed int64) *Session {
+	dir := t.TempDir()
+	stats, err := store.OpenStats(filepath.Join(dir, "stats.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Session{
+		boards:       1,
+		options:      []wordle.Option{wordle.WithLength(4)},
+		dailyPuzzle:  -1,
+		practiceSeed: seed,
+		stats:        stats,
+		savePath:     filepath.Join(dir, "game.json"),
+		sharePalette: wordle.Classic,
+	}
+	s.game = s.newGame()
+	return s
+}
+
+// types a word for the session, clearing what was typed before, and submits
+// it like pressing enter does. It reports whether the game took the guess.
+func play(s *Session, word string) (bool, error) {
+	for len(s.typed) > 0 {
+		s.deleteLetter()
+	}
+	for _, c := range word {
+		s.typeLetter(c)
+	}
+	return s.submit()
+}
+
+// two sessions, like the ones of two ssh players in the same server, play at
+// the same time. Run with -race, this catches state shared between them.
+func TestSessionsRace(t *testing.T) {
+	const games = 5
+	var wg sync.WaitGroup
+	for i := int64(1); i <= 2; i++ {
+		s := newTestSession(t, i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < games; n++ {
+				solver.Opening(solver.Entropy, s.game.Length())
+				target := s.game.Boards()[0].Target()
+				for _, word := range []string{"xxxx", "abet"} {
+					if _, err := play(s, word); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+
+				// the saved game is this session's, not the other one's
+				saved, err := store.LoadGame(s.savePath)
+				if err != nil || saved == nil {
+					t.Errorf("loading the saved game: %v", err)
+					return
+				}
+				if got := saved.Snapshot(); got.Target != target || len(got.Guesses) != 1 || got.Guesses[0] != "abet" {
+					t.Errorf("saved game has target %q and guesses %v, want %q and [abet]", got.Target, got.Guesses, target)
+				}
+
+				if taken, err := play(s, target); !taken || err != nil {
+					t.Errorf("guessing the target: taken %t, %v", taken, err)
+					return
+				}
+				if got := s.game.Snapshot(); got.Target != target || len(got.Guesses) != 2 || got.Guesses[1] != target {
+					t.Errorf("game %d has target %q and guesses %v, want %q and [abet %s]", n, got.Target, got.Guesses, target, target)
+				}
+				if s.game.State() != wordle.Won || s.lastShare == "" {
+					t.Errorf("game %d ended %s without a share grid", n, s.game.State())
+					return
+				}
+				s.restart()
+			}
+			if sum := s.stats.Summary(store.VariantOf(s.game)); sum.Played != games || sum.Won != games {
+				t.Errorf("statistics have %d games and %d wins, want %d of each", sum.Played, sum.Won, games)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/jroimartin/gocui"
+)
+
+// copies the share grid of the finished game to the system clipboard
+func (s *Session) copyShare(g *gocui.Gui, v *gocui.View) error {
+	if !s.game.Over() {
+		return nil
+	}
+	if err := copyToClipboard(os.Stdout, s.game.Share(s.sharePalette)); err != nil {
+		s.setStatus(g, "Couldn't copy to the clipboard")
+		return nil
+	}
+	setColoredStatus(g, s.colors.Accent, "Copied results to the clipboard")
+	return nil
+}
+
+// asks the terminal to put text on the clipboard with an OSC 52 escape
+// sequence. This needs no external tools and also works over SSH, as long as
+// the terminal supports it.
+func copyToClipboard(w io.Writer, text string) error {
+	_, err := fmt.Fprintf(w, "\x1b]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(text)))
+	return err
+}
+
+// writes the share grid of the last finished game to path, or to stdout if
+// path is "-"
+func (s *Session) writeShare(path string) error {
+	if s.lastShare == "" {
+		return nil
+	}
+	if path == "-" {
+		_, err := fmt.Println(s.lastShare)
+		return err
+	}
+	return os.WriteFile(path, []byte(s.lastShare+"\n"), 0o644)
+}
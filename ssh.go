@@ -0,0 +1,297 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/x2dtu/wordle/wordle/store"
+)
+
+// runs "wordle ssh": every connection gets its own game in its terminal.
+// Players are told apart by their public key, and each key gets its own
+// saved game and statistics.
+func runSSH(args []string) int {
+	flags := flag.NewFlagSet("ssh", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: wordle ssh [options] [-- game options]")
+		flags.PrintDefaults()
+	}
+	addr := flags.String("addr", ":2222", "address to listen on")
+	hostKey := flags.String("host-key", "", "host key file, created if missing (default in the data directory)")
+	users := flags.String("users", "", "directory player files are kept in (default in the data directory)")
+	flags.Parse(args)
+
+	if *hostKey == "" || *users == "" {
+		dir, err := store.DataDir()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		if *hostKey == "" {
+			*hostKey = filepath.Join(dir, "ssh_host_ed25519_key")
+		}
+		if *users == "" {
+			*users = filepath.Join(dir, "users")
+		}
+	}
+	signer, err := loadHostKey(*hostKey)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	self, err := os.Executable()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	config := &ssh.ServerConfig{
+		// anyone can play, the key only says whose stats to use
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			sum := sha256.Sum256(key.Marshal())
+			return &ssh.Permissions{
+				Extensions: map[string]string{"player": hex.EncodeToString(sum[:])},
+			}, nil
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	log.Printf("serving games on ssh://%s (%s)", *addr, ssh.FingerprintSHA256(signer.PublicKey()))
+	server := &sshServer{config: config, self: self, users: *users, gameArgs: flags.Args(), playing: make(map[string]bool)}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Println(err)
+			return 1
+		}
+		go server.handle(conn)
+	}
+}
+
+// loadHostKey reads the server's private key, generating and saving a new
+// one the first time
+func loadHostKey(path string) (ssh.Signer, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return newHostKey(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ssh.ParsePrivateKey(data)
+}
+
+func newHostKey(path string) (ssh.Signer, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, pem.EncodeToMemory(block), 0o600); err != nil {
+		return nil, err
+	}
+	log.Printf("created host key %s", path)
+	return ssh.NewSignerFromKey(key)
+}
+
+type sshServer struct {
+	config *ssh.ServerConfig
+	// path to this program, which is started once per session to run the
+	// game in the session's terminal
+	self     string
+	users    string
+	gameArgs []string
+
+	mu sync.Mutex
+	// data directories of the players with a game running
+	playing map[string]bool
+}
+
+// claim marks the player whose files are in dataDir as playing, or reports
+// false if they already are. Two games of the same player would overwrite
+// each other's saved game and statistics, so each player gets one at a time.
+func (s *sshServer) claim(dataDir string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.playing[dataDir] {
+		return false
+	}
+	s.playing[dataDir] = true
+	return true
+}
+
+func (s *sshServer) release(dataDir string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.playing, dataDir)
+}
+
+func (s *sshServer) handle(conn net.Conn) {
+	serverConn, channels, requests, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		log.Printf("%s: %v", conn.RemoteAddr(), err)
+		return
+	}
+	defer serverConn.Close()
+	player := serverConn.Permissions.Extensions["player"]
+	log.Printf("%s: %s connected as %.12s", conn.RemoteAddr(), serverConn.User(), player)
+
+	go ssh.DiscardRequests(requests)
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "only sessions are supported")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			log.Printf("%s: %v", conn.RemoteAddr(), err)
+			continue
+		}
+		go s.session(channel, requests, filepath.Join(s.users, player))
+	}
+	log.Printf("%s: disconnected", conn.RemoteAddr())
+}
+
+// payload of a "pty-req" request, RFC 4254 section 6.2
+type ptyRequest struct {
+	Term          string
+	Columns, Rows uint32
+	Width, Height uint32
+	Modes         string
+}
+
+// payload of a "window-change" request, RFC 4254 section 6.7
+type windowChange struct {
+	Columns, Rows uint32
+	Width, Height uint32
+}
+
+// session serves one channel: it waits for a terminal and a shell, then runs
+// a game in them until either side hangs up
+func (s *sshServer) session(channel ssh.Channel, requests <-chan *ssh.Request, dataDir string) {
+	defer channel.Close()
+	var term *ptyTerminal
+	defer func() {
+		if term != nil {
+			term.Close()
+		}
+	}()
+
+	done := make(chan uint32, 1)
+	// a channel runs one shell, later requests for another are refused
+	started := false
+	for {
+		select {
+		case status := <-done:
+			channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{status}))
+			return
+		case req, ok := <-requests:
+			if !ok {
+				return
+			}
+			switch req.Type {
+			case "pty-req":
+				var p ptyRequest
+				if term != nil || ssh.Unmarshal(req.Payload, &p) != nil {
+					req.Reply(false, nil)
+					continue
+				}
+				t, err := openPTY()
+				if err != nil {
+					log.Println(err)
+					req.Reply(false, nil)
+					continue
+				}
+				term = t
+				term.name = p.Term
+				term.Resize(int(p.Columns), int(p.Rows))
+				req.Reply(true, nil)
+			case "window-change":
+				var w windowChange
+				if term != nil && ssh.Unmarshal(req.Payload, &w) == nil {
+					term.Resize(int(w.Columns), int(w.Rows))
+				}
+				if req.WantReply {
+					req.Reply(true, nil)
+				}
+			case "shell":
+				if started {
+					req.Reply(false, nil)
+					continue
+				}
+				started = true
+				if term == nil {
+					req.Reply(true, nil)
+					fmt.Fprint(channel, "wordle needs a terminal, try connecting with ssh -t\r\n")
+					done <- 1
+					continue
+				}
+				if !s.claim(dataDir) {
+					req.Reply(true, nil)
+					fmt.Fprint(channel, "you're already playing wordle in another session with this key\r\n")
+					done <- 1
+					continue
+				}
+				cmd, err := s.startGame(term, dataDir)
+				if err != nil {
+					s.release(dataDir)
+					log.Println(err)
+					req.Reply(false, nil)
+					return
+				}
+				req.Reply(true, nil)
+				go io.Copy(term.master, channel)
+				go func() {
+					io.Copy(channel, term.master)
+					// the copy ends once the game has exited and its output
+					// has been sent
+					status := exitStatus(cmd.Wait())
+					s.release(dataDir)
+					done <- status
+				}()
+			default:
+				if req.WantReply {
+					req.Reply(false, nil)
+				}
+			}
+		}
+	}
+}
+
+func exitStatus(err error) uint32 {
+	var exit *exec.ExitError
+	if errors.As(err, &exit) {
+		return uint32(exit.ExitCode())
+	}
+	if err != nil {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jroimartin/gocui"
+	"github.com/x2dtu/wordle/wordle"
+	"github.com/x2dtu/wordle/wordle/store"
+)
+
+const STATS_WIDTH = 36
+const STATS_HEIGHT = 17
+const MAX_BAR_LEN = 24
+
+// records the result of the game that just ended
+func (s *Session) recordResult() error {
+	s.lastShare = s.game.Share(s.sharePalette)
+	// a daily puzzle recorded before its guesses were kept can be played
+	// again, but only counts once
+	if s.game.Mode() == wordle.Daily {
+		if _, ok := s.stats.Daily(s.game.Puzzle(), store.VariantOf(s.game)); ok {
+			return nil
+		}
+	}
+	return s.stats.Add(store.NewRecord(s.game, time.Now()))
+}
+
+// opens the statistics screen over the board, or closes it if it's open
+func (s *Session) toggleStats(g *gocui.Gui, v *gocui.View) error {
+	if _, err := g.View("stats"); err == nil {
+		return closeStats(g, v)
+	}
+
+	x0, y0, x1, y1, err := s.statsBounds(g)
+	if err != nil {
+		return err
+	}
+	v, err = g.SetView("stats", x0, y0, x1, y1)
+	if err != nil && err != gocui.ErrUnknownView {
+		return err
+	}
+	v.Title = " Statistics "
+	s.printStats(v, s.stats.Summary(store.VariantOf(s.game)))
+	_, err = g.SetCurrentView("stats")
+	return err
+}
+
+// where the statistics screen goes: centered, from the top of the first board
+func (s *Session) statsBounds(g *gocui.Gui) (int, int, int, int, error) {
+	maxX, _ := g.Size()
+	_, y0, _, _, err := g.ViewPosition("input")
+	// there's a bar for each try, up to the longest distribution that fits
+	height := STATS_HEIGHT + s.distributionBars() - wordle.MaxGuesses
+	return maxX/2 - STATS_WIDTH/2, y0, maxX/2 + STATS_WIDTH/2, y0 + height, err
+}
+
+func closeStats(g *gocui.Gui, v *gocui.View) error {
+	if err := g.DeleteView("stats"); err != nil {
+		return err
+	}
+	_, err := g.SetCurrentView("input")
+	return err
+}
+
+// number of bars in the guess distribution, one for each try of the current
+// game. With unlimited tries the bars stop at the usual number of tries.
+func (s *Session) distributionBars() int {
+	if s.game.Tries() == wordle.Unlimited {
+		return wordle.MaxGuesses
+	}
+	return s.game.Tries()
+}
+
+func (s *Session) printStats(v *gocui.View, sum store.Summary) {
+	mode := s.game.Mode().String()
+	heading := strings.ToUpper(mode[:1]) + mode[1:] + " games"
+	if len(s.game.Boards()) > 1 || s.game.Lies() > 0 {
+		heading += ", " + s.game.Name()
+	}
+	if s.game.Lies() > 1 {
+		heading += fmt.Sprintf(", %d lies", s.game.Lies())
+	}
+	if s.game.Length() != wordle.WordLength {
+		heading += fmt.Sprintf(", %d letters", s.game.Length())
+	}
+	fmt.Fprintf(v, "  %s%s%s\n\n", s.colors.Accent, heading, RESET)
+	fmt.Fprintf(v, "  %6d %6d %6d %6d\n", sum.Played, sum.WinPercent(), sum.CurrentStreak, sum.MaxStreak)
+	fmt.Fprintln(v, "  Played   Win%  Streak   Max")
+	if sum.Hinted > 0 {
+		fmt.Fprintf(v, "  %s(%d won with hints)%s\n", s.colors.Muted, sum.Hinted, RESET)
+	} else {
+		fmt.Fprintln(v)
+	}
+	fmt.Fprintln(v)
+	fmt.Fprintf(v, "  %sGuess Distribution%s\n", s.colors.Accent, RESET)
+
+	// wins that took more guesses than there are bars share an extra bar
+	bars := s.distributionBars()
+	for len(sum.Distribution) < bars {
+		sum.Distribution = append(sum.Distribution, 0)
+	}
+	counts := sum.Distribution[:bars]
+	if len(sum.Distribution) > bars {
+		rest := 0
+		for _, count := range sum.Distribution[bars:] {
+			rest += count
+		}
+		counts = append(counts[:len(counts):len(counts)], rest)
+	}
+
+	most := 1
+	for _, count := range counts {
+		if count > most {
+			most = count
+		}
+	}
+	for i, count := range counts {
+		// the bar for the game that was just won is highlighted
+		color := s.colors.Muted
+		guesses := s.game.Guesses()
+		if guesses > bars {
+			guesses = bars + 1
+		}
+		if s.game.State() == wordle.Won && guesses == i+1 {
+			color = s.colors.Correct
+		}
+		bar := strings.Repeat("█", 1+count*(MAX_BAR_LEN-1)/most)
+		label := fmt.Sprint(i + 1)
+		if i == bars {
+			label += "+"
+		}
+		fmt.Fprintf(v, "  %-2s %s%s%s %d\n", label, color, bar, RESET, count)
+	}
+	fmt.Fprintf(v, "\n  Close: %s^T%s\n", s.colors.Accent, RESET)
+}
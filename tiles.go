@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jroimartin/gocui"
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// tileMode is how the letters on the boards are drawn
+type tileMode int
+
+const (
+	// letters colored by their state, one line per guess
+	compactTiles tileMode = iota
+	// letters on tiles filled with the color of their state
+	filledTiles
+	// filled tiles with a box drawn around each of them
+	boxedTiles
+)
+
+var tileModeNames = map[tileMode]string{
+	compactTiles: "compact",
+	filledTiles:  "filled",
+	boxedTiles:   "boxed",
+}
+
+// parses the value of the -tiles flag. auto is true for "auto", which picks
+// the biggest tiles that fit the terminal.
+func parseTileMode(name string) (mode tileMode, auto bool, err error) {
+	if name == "auto" {
+		return boxedTiles, true, nil
+	}
+	for m, n := range tileModeNames {
+		if n == name {
+			return m, false, nil
+		}
+	}
+	return 0, false, fmt.Errorf("unknown tiles %q, expected auto, boxed, filled or compact", name)
+}
+
+// columns each letter takes up, including the gap after it
+func (m tileMode) step() int {
+	switch m {
+	case boxedTiles:
+		return 5 // "│ A │"
+	case filledTiles:
+		return 4 // " A " and a space
+	}
+	return 1
+}
+
+// lines each row of tiles takes up
+func (m tileMode) height() int {
+	switch m {
+	case boxedTiles:
+		return 3
+	case filledTiles:
+		return 2 // a blank line and the tiles under it
+	}
+	return 1
+}
+
+// tile is a letter on a board and how it's drawn
+type tile struct {
+	letter byte // 0 for a blank tile
+	style  string
+	// filled tiles are drawn in reverse, so their color is the background
+	filled bool
+	// suspected lies are underlined, and the tile being marked is bracketed
+	marked   bool
+	selected bool
+	// animated tiles can be halfway through flipping over, or raised
+	flipping bool
+	raised   bool
+}
+
+// tiles of the guess in row i of a board: correct and present letters are
+// filled with the color of their state and absent ones aren't
+func (s *Session) guessTiles(row wordle.Row, i int) []tile {
+	tiles := make([]tile, len(row.Word))
+	for col := range row.Word {
+		t := tile{letter: row.Word[col]}
+		switch row.Feedback[col] {
+		case wordle.Correct:
+			t.style, t.filled = s.colors.Correct, true
+		case wordle.Present:
+			t.style, t.filled = s.colors.Present, true
+		default:
+			t.style = s.colors.Absent
+		}
+		t.marked = i < len(s.marks) && s.marks[i][col]
+		t.selected = s.marking && i == s.markRow && col == s.markCol
+		tiles[col] = t
+	}
+	return tiles
+}
+
+// tiles of a word that hasn't been scored, like the one being typed, blank
+// after its last letter
+func (s *Session) wordTiles(word string, style string) []tile {
+	tiles := make([]tile, s.wordLen())
+	for i := range tiles {
+		tiles[i].style = style
+		if i < len(word) {
+			tiles[i].letter = word[i]
+		}
+	}
+	return tiles
+}
+
+// draws a row of tiles, centered on the board like every word unless it's
+// shifted by some columns
+func (s *Session) drawTiles(v *gocui.View, tiles []tile, shift int) {
+	lines := make([]strings.Builder, s.tiles.height())
+	for i := range lines {
+		lines[i].WriteString(strings.Repeat(" ", s.wordStart()+shift))
+	}
+	for i, t := range tiles {
+		letter := "_"
+		if t.letter != 0 {
+			letter = string(t.letter)
+		}
+		if s.tiles == compactTiles {
+			// the tile being marked is reversed, as there is no room for
+			// brackets. Every tile starts from a reset, since a theme's styles
+			// can have attributes that would otherwise carry over.
+			lines[0].WriteString(RESET + t.style)
+			if t.marked {
+				lines[0].WriteString(UNDERLINE)
+			}
+			if t.selected {
+				lines[0].WriteString(REVERSE)
+			}
+			// there's no room to move a raised letter either, so it's
+			// capitalized
+			if t.raised {
+				letter = strings.ToUpper(letter)
+			}
+			lines[0].WriteString(letter)
+			continue
+		}
+
+		if t.letter == 0 {
+			letter = " "
+		}
+		left, right := " ", " "
+		if t.selected {
+			left, right = "[", "]"
+		}
+		fill := RESET + t.style
+		if t.filled {
+			fill += REVERSE
+		}
+		face := fill + left
+		if t.marked {
+			face += UNDERLINE
+		}
+		// underlining can only be turned off with a reset, so the fill is
+		// started over for the right side
+		face += strings.ToUpper(letter) + fill + right + RESET
+		// a tile halfway through flipping over is seen edge on
+		if t.flipping {
+			face = RESET + t.style + "───" + RESET
+		}
+
+		if s.tiles == filledTiles {
+			if t.letter == 0 {
+				face = RESET + t.style + " _ " + RESET
+			}
+			// a raised tile moves up into the blank line above it
+			above, below := "   ", face
+			if t.raised {
+				above, below = face, "   "
+			}
+			if i > 0 {
+				lines[0].WriteString(" ")
+				lines[1].WriteString(" ")
+			}
+			lines[0].WriteString(above)
+			lines[1].WriteString(below)
+			continue
+		}
+		border := RESET + t.style
+		top, middle := "┌───┐", "│"+face+border+"│"
+		// the letter of a raised tile jumps up onto its top edge
+		if t.raised {
+			top = "┌─" + fill + strings.ToUpper(letter) + border + "─┐"
+			middle = "│" + fill + "   " + border + "│"
+		}
+		lines[0].WriteString(border + top)
+		lines[1].WriteString(border + middle)
+		lines[2].WriteString(border + "└───┘")
+	}
+	for i := range lines {
+		fmt.Fprintln(v, lines[i].String()+RESET)
+	}
+}
+
+// number of columns the words on a board take up
+func (s *Session) wordWidth() int {
+	width := s.wordLen() * s.tiles.step()
+	if s.tiles == filledTiles {
+		width-- // no gap after the last tile
+	}
+	return width
+}
+
+// width of the view a single board is drawn in, which is wide enough for
+// the end of game messages and leaves a space on both sides of the words
+func (s *Session) inputWidth() int {
+	width := s.wordWidth() + 4
+	if width%2 == 1 {
+		width++
+	}
+	if width < INPUT_WIDTH {
+		return INPUT_WIDTH
+	}
+	return width
+}
+
+// number of lines of a board the rows of tiles take up
+func (s *Session) boardLines() int {
+	return s.boardRows() * s.tiles.height()
+}
@@ -0,0 +1,68 @@
+package wordle
+
+// WithAdversary makes the game adversarial, like Absurdle. Instead of picking
+// a target up front, the game keeps every answer that is still possible and
+// answers each guess with the feedback that leaves the most of them, only
+// settling on a target once a single answer is left.
+func WithAdversary() Option {
+	return func(w *Wordle) {
+		w.mode = Adversarial
+	}
+}
+
+// dodge answers guess for a game that hasn't settled on a target yet. The
+// remaining answers are grouped by the feedback guess would get from them,
+// and the biggest group is kept. Ties go to the feedback that gives the
+// least away: the one with the fewest green and then the fewest yellow tiles.
+func (w *Wordle) dodge(guess string) Feedback {
+	buckets := make(map[int][]string)
+	for _, answer := range w.candidates {
+		code := ScoreCode(guess, answer)
+		buckets[code] = append(buckets[code], answer)
+	}
+
+	best := -1
+	for code, bucket := range buckets {
+		if best < 0 || len(bucket) > len(buckets[best]) ||
+			len(bucket) == len(buckets[best]) && revealsLess(code, best, len(guess)) {
+			best = code
+		}
+	}
+
+	w.candidates = buckets[best]
+	if len(w.candidates) == 1 {
+		w.target = w.candidates[0]
+		w.candidates = nil
+	}
+	return Score(guess, buckets[best][0])
+}
+
+// revealsLess reports whether the feedback code a gives away less than b:
+// fewer green tiles, then fewer yellow tiles, then the lower code so the
+// choice never depends on map order
+func revealsLess(a int, b int, length int) bool {
+	greenA, yellowA := tileCounts(a, length)
+	greenB, yellowB := tileCounts(b, length)
+	if greenA != greenB {
+		return greenA < greenB
+	}
+	if yellowA != yellowB {
+		return yellowA < yellowB
+	}
+	return a < b
+}
+
+// tileCounts returns the number of green and yellow tiles in a feedback code
+func tileCounts(code int, length int) (int, int) {
+	greens, yellows := 0, 0
+	for i := 0; i < length; i++ {
+		switch TileState(code % 3) {
+		case Correct:
+			greens++
+		case Present:
+			yellows++
+		}
+		code /= 3
+	}
+	return greens, yellows
+}
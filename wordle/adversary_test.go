@@ -0,0 +1,79 @@
+package wordle
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDodge(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, length := range []int{4, 5, 6} {
+		answers := Answers(length)
+		for game := 0; game < 5; game++ {
+			w := New(WithAdversary(), WithLength(length), WithTries(Unlimited))
+			for !w.Over() {
+				guess := answers[rng.Intn(len(answers))]
+				if w.target != "" {
+					guess = w.target
+				}
+
+				// the answers still possible, grouped by the feedback guess
+				// would get from them
+				biggest := 0
+				if w.target == "" {
+					sizes := make(map[int]int)
+					for _, answer := range w.candidates {
+						code := ScoreCode(guess, answer)
+						sizes[code]++
+						if sizes[code] > biggest {
+							biggest = sizes[code]
+						}
+					}
+				}
+
+				if _, err := w.Guess(guess); err != nil {
+					t.Fatal(err)
+				}
+				if biggest == 0 {
+					continue
+				}
+				left := len(w.candidates)
+				if w.target != "" {
+					left = 1
+				}
+				if left != biggest {
+					t.Fatalf("%q left %d answers, want the biggest group of %d", guess, left, biggest)
+				}
+			}
+
+			// every row has to be what the target it settled on would give
+			for _, row := range w.Rows() {
+				if want := Score(row.Word, w.Target()); row.Feedback.String() != want.String() {
+					t.Errorf("%q got %s, but target %q gives %s", row.Word, row.Feedback, w.Target(), want)
+				}
+			}
+			if w.State() != Won {
+				t.Errorf("game ended %s after %d guesses", w.State(), w.Guesses())
+			}
+		}
+	}
+}
+
+func TestDodgeConcede(t *testing.T) {
+	// with two answers left, even guessing one of them is dodged
+	w := New(WithAdversary())
+	w.candidates = []string{"hedge", "ledge"}
+	if feedback, _ := w.Guess("ledge"); feedback.String() != "BGGGG" || w.Target() != "hedge" {
+		t.Errorf("Guess(ledge) = %s with target %q, want BGGGG with hedge", feedback, w.Target())
+	}
+
+	// with one answer left it has to be the target
+	w = New(WithAdversary())
+	w.candidates = []string{"ledge"}
+	if feedback, _ := w.Guess("crane"); feedback.String() != "BBBBG" || w.Target() != "ledge" {
+		t.Errorf("Guess(crane) = %s with target %q, want BBBBG with ledge", feedback, w.Target())
+	}
+	if feedback, _ := w.Guess("ledge"); !feedback.Solved() || w.State() != Won {
+		t.Errorf("Guess(ledge) = %s, state %s, want it solved", feedback, w.State())
+	}
+}
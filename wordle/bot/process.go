@@ -0,0 +1,150 @@
+package bot
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+var (
+	ErrTimeout      = errors.New("took too long to guess")
+	ErrExited       = errors.New("exited")
+	ErrInvalidGuess = errors.New("invalid guess")
+)
+
+// how long a bot has to exit once its input is closed before it's killed
+const closeTimeout = time.Second
+
+// Process is a bot running as a subprocess
+type Process struct {
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	encoder *json.Encoder
+	// lines the bot wrote, closed once it closes its output
+	lines chan []byte
+}
+
+// Start runs a bot. Whatever it writes to its standard error is passed on to
+// ours.
+func Start(name string, args ...string) (*Process, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	p := &Process{cmd: cmd, stdin: stdin, encoder: json.NewEncoder(stdin), lines: make(chan []byte)}
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			p.lines <- append([]byte(nil), scanner.Bytes()...)
+		}
+		close(p.lines)
+	}()
+	return p, nil
+}
+
+func (p *Process) send(m Message) error {
+	// writing only fails once the bot has closed its input
+	if err := p.encoder.Encode(m); err != nil {
+		return ErrExited
+	}
+	return nil
+}
+
+// receive waits at most timeout for the next message from the bot
+func (p *Process) receive(timeout time.Duration) (Message, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case line, ok := <-p.lines:
+		if !ok {
+			return Message{}, ErrExited
+		}
+		return decode(line)
+	case <-timer.C:
+		return Message{}, ErrTimeout
+	}
+}
+
+// Result is how a game played by a bot went
+type Result struct {
+	Won     bool
+	Guesses int
+	// Err says why the bot forfeited the game, if it did. Games forfeited
+	// with an ErrInvalidGuess leave the bot ready for the next game; after
+	// any other error it should be restarted.
+	Err error
+}
+
+// Play has the bot play w, which is announced as the given game number. The
+// bot has at most timeout to make each guess.
+func (p *Process) Play(game int, w *wordle.Wordle, timeout time.Duration) Result {
+	forfeit := func(err error) Result {
+		return Result{Guesses: w.Guesses(), Err: err}
+	}
+
+	err := p.send(Message{Type: NewGame, Version: Version, Game: game, Length: w.Length(), Tries: w.Tries(), Difficulty: w.Difficulty().String()})
+	if err != nil {
+		return forfeit(err)
+	}
+	for !w.Over() {
+		m, err := p.receive(timeout)
+		if err != nil {
+			return forfeit(err)
+		}
+		if m.Type != Guess {
+			return forfeit(fmt.Errorf("sent a %q message instead of a guess", m.Type))
+		}
+
+		reply := Message{Type: Feedback, Game: game, Word: m.Word}
+		feedback, err := w.Guess(m.Word)
+		if err != nil {
+			reply.Error = err.Error()
+			reply.State = wordle.Lost.String()
+			reply.Target = w.Target()
+			if err := p.send(reply); err != nil {
+				return forfeit(err)
+			}
+			return forfeit(fmt.Errorf("%w %q: %v", ErrInvalidGuess, m.Word, err))
+		}
+		reply.Feedback = feedback.String()
+		reply.State = w.State().String()
+		if w.Over() {
+			reply.Target = w.Target()
+		}
+		if err := p.send(reply); err != nil {
+			return forfeit(err)
+		}
+	}
+	return Result{Won: w.State() == wordle.Won, Guesses: w.Guesses()}
+}
+
+// Close closes the bot's input, which tells it there are no more games, and
+// waits for it to exit. Bots that don't exit in time are killed.
+func (p *Process) Close() error {
+	p.stdin.Close()
+	timer := time.AfterFunc(closeTimeout, func() {
+		p.cmd.Process.Kill()
+	})
+	defer timer.Stop()
+	for range p.lines {
+		// anything written after the last game doesn't matter
+	}
+	return p.cmd.Wait()
+}
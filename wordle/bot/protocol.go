@@ -0,0 +1,80 @@
+// Package bot lets programs play Wordle over a JSON lines protocol on their
+// standard input and output, so bots can be written in any language.
+//
+// Every line is a Message. For each game the bot gets a new_game message, and
+// then answers with a guess message until the game is over. Each guess gets a
+// feedback message, and the last one of a game also has the state the game
+// ended in and its target. An invalid guess ends the game, which the feedback
+// message says in its error, and so does a guess that breaks the rules of a
+// hard or ultra hard game. The game closes the bot's input once it's done.
+//
+//	> {"type":"new_game","version":2,"game":1,"length":5,"tries":6,"difficulty":"normal"}
+//	< {"type":"guess","word":"crane"}
+//	> {"type":"feedback","game":1,"word":"crane","feedback":"BYBBB","state":"playing"}
+//	< {"type":"guess","word":"sorry"}
+//	> {"type":"feedback","game":1,"word":"sorry","feedback":"GGGGG","state":"won","target":"sorry"}
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// Version is the version of the protocol, sent with every new_game message.
+// It changes whenever a change to the protocol could break existing bots.
+const Version = 2
+
+// types of messages
+const (
+	NewGame  = "new_game" // a game is starting
+	Guess    = "guess"    // the bot's next guess
+	Feedback = "feedback" // the answer to a guess
+)
+
+// Message is one line of the protocol. Type says which of the other fields
+// are set.
+type Message struct {
+	Type string `json:"type"`
+
+	// new_game
+	Version int `json:"version,omitempty"`
+	Length  int `json:"length,omitempty"`
+	// Tries is the number of guesses allowed, or -1 when there's no limit
+	Tries int `json:"tries,omitempty"`
+	// Difficulty is "normal", "hard" or "ultra hard", the names
+	// wordle.Difficulty prints. Added in version 2.
+	Difficulty string `json:"difficulty,omitempty"`
+
+	// new_game and feedback
+	Game int `json:"game,omitempty"`
+
+	// guess and feedback
+	Word string `json:"word,omitempty"`
+
+	// feedback
+	Feedback string `json:"feedback,omitempty"`
+	State    string `json:"state,omitempty"`
+	Target   string `json:"target,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// decode reads a message from a line of the protocol
+func decode(line []byte) (Message, error) {
+	var m Message
+	if err := json.Unmarshal(line, &m); err != nil {
+		return m, fmt.Errorf("invalid message %q: %v", line, err)
+	}
+	return m, nil
+}
+
+// parseDifficulty reads the difficulty of a new_game message
+func parseDifficulty(s string) (wordle.Difficulty, error) {
+	for d := wordle.Normal; d <= wordle.UltraHard; d++ {
+		if s == d.String() {
+			return d, nil
+		}
+	}
+	return wordle.Normal, fmt.Errorf("unknown difficulty %q", s)
+}
@@ -0,0 +1,60 @@
+package bot
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// Player picks the guesses of a bot written in Go
+type Player interface {
+	// NewGame starts a game with words of the given length. Guesses have to
+	// respect the hints of the given difficulty.
+	NewGame(length int, difficulty wordle.Difficulty)
+	// Guess returns the next guess
+	Guess() string
+	// Update tells the player the feedback its last guess got
+	Update(guess string, feedback wordle.Feedback)
+}
+
+// Serve is the bot side of the protocol: it reads messages from in and
+// writes p's guesses to out, until in is closed
+func Serve(in io.Reader, out io.Writer, p Player) error {
+	scanner := bufio.NewScanner(in)
+	encoder := json.NewEncoder(out)
+	for scanner.Scan() {
+		m, err := decode(scanner.Bytes())
+		if err != nil {
+			return err
+		}
+		switch m.Type {
+		case NewGame:
+			if m.Version != Version {
+				return fmt.Errorf("unsupported protocol version %d, expected %d", m.Version, Version)
+			}
+			difficulty, err := parseDifficulty(m.Difficulty)
+			if err != nil {
+				return err
+			}
+			p.NewGame(m.Length, difficulty)
+		case Feedback:
+			if m.State != wordle.Playing.String() {
+				continue // wait for the next game
+			}
+			feedback, err := wordle.ParseFeedback(m.Feedback)
+			if err != nil {
+				return err
+			}
+			p.Update(m.Word, feedback)
+		default:
+			continue
+		}
+		if err := encoder.Encode(Message{Type: Guess, Word: p.Guess()}); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
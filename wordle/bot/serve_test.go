@@ -0,0 +1,51 @@
+package bot
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// a player that always guesses the same word and remembers its last game
+type fixedPlayer struct {
+	length     int
+	difficulty wordle.Difficulty
+}
+
+func (p *fixedPlayer) NewGame(length int, difficulty wordle.Difficulty) {
+	p.length, p.difficulty = length, difficulty
+}
+
+func (p *fixedPlayer) Guess() string {
+	return "crane"
+}
+
+func (p *fixedPlayer) Update(guess string, feedback wordle.Feedback) {}
+
+func TestServeDifficulty(t *testing.T) {
+	for _, d := range []wordle.Difficulty{wordle.Normal, wordle.Hard, wordle.UltraHard} {
+		in := `{"type":"new_game","version":2,"game":1,"length":5,"tries":6,"difficulty":"` + d.String() + `"}` + "\n"
+		var out bytes.Buffer
+		p := &fixedPlayer{}
+		if err := Serve(strings.NewReader(in), &out, p); err != nil {
+			t.Fatalf("Serve with a %s game: %v", d, err)
+		}
+		if p.length != 5 || p.difficulty != d {
+			t.Errorf("player got a %d letter %s game, want a 5 letter %s one", p.length, p.difficulty, d)
+		}
+		if out.String() != `{"type":"guess","word":"crane"}`+"\n" {
+			t.Errorf("Serve wrote %q", out.String())
+		}
+	}
+
+	for _, in := range []string{
+		`{"type":"new_game","version":2,"game":1,"length":5,"tries":6,"difficulty":"impossible"}`,
+		`{"type":"new_game","version":1,"game":1,"length":5,"tries":6}`,
+	} {
+		if err := Serve(strings.NewReader(in+"\n"), &bytes.Buffer{}, &fixedPlayer{}); err == nil {
+			t.Errorf("Serve(%s) should fail", in)
+		}
+	}
+}
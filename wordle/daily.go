@@ -0,0 +1,66 @@
+package wordle
+
+import "time"
+
+// Epoch is the day of the first daily puzzle, numbered 0. It matches the
+// original Wordle so puzzle numbers line up with the NYT's.
+var Epoch = time.Date(2021, time.June, 19, 0, 0, 0, 0, time.UTC)
+
+// Mode is how the target of a game was picked
+type Mode int
+
+const (
+	// Practice games get a random target
+	Practice Mode = iota
+	// Daily games get the target of a numbered puzzle, the same for everyone
+	Daily
+	// Adversarial games put off picking a target for as long as they can,
+	// see WithAdversary
+	Adversarial
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Daily:
+		return "daily"
+	case Adversarial:
+		return "adversarial"
+	default:
+		return "practice"
+	}
+}
+
+// PuzzleNumber returns the number of the daily puzzle for the calendar day
+// that t falls on in loc. Days before epoch give negative numbers.
+func PuzzleNumber(t time.Time, epoch time.Time, loc *time.Location) int {
+	year, month, day := t.In(loc).Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	year, month, day = epoch.Date()
+	first := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return int(today.Sub(first).Hours() / 24)
+}
+
+// PuzzleDate returns the calendar day of the given puzzle number
+func PuzzleDate(number int, epoch time.Time) time.Time {
+	return epoch.AddDate(0, 0, number)
+}
+
+// puzzleWord returns the target of the given daily puzzle for words of the
+// given length. Targets are taken from the answer list in its original order,
+// wrapping around once the list runs out.
+func puzzleWord(length int, number int) string {
+	answers := wordLists[length].answers
+	index := number % len(answers)
+	if index < 0 {
+		index += len(answers)
+	}
+	return answers[index]
+}
+
+// WithPuzzle makes the game the daily puzzle with the given number
+func WithPuzzle(number int) Option {
+	return func(w *Wordle) {
+		w.mode = Daily
+		w.puzzle = number
+	}
+}
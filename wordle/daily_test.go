@@ -0,0 +1,86 @@
+package wordle
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestPuzzleNumber(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	west := time.FixedZone("UTC-5", -5*60*60)
+	east := time.FixedZone("UTC+9", 9*60*60)
+	utc := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2021, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		t    time.Time
+		loc  *time.Location
+		want int
+	}{
+		{Epoch, time.UTC, 0},
+		{utc(time.June, 19, 23, 59), time.UTC, 0},
+		{utc(time.June, 20, 0, 0), time.UTC, 1},
+		{utc(time.June, 18, 23, 59), time.UTC, -1},
+		// the day changes at midnight in loc, not in UTC
+		{utc(time.June, 20, 4, 59), west, 0},
+		{utc(time.June, 20, 5, 0), west, 1},
+		{Epoch, west, -1},
+		{utc(time.June, 18, 14, 59), east, -1},
+		{utc(time.June, 18, 15, 0), east, 0},
+		{utc(time.June, 19, 14, 59), east, 0},
+		{utc(time.June, 19, 15, 0), east, 1},
+		// days stay whole across daylight saving changes
+		{time.Date(2021, time.November, 7, 0, 30, 0, 0, newYork), newYork, 141},
+		{time.Date(2021, time.November, 7, 23, 30, 0, 0, newYork), newYork, 141},
+		{time.Date(2022, time.March, 13, 23, 30, 0, 0, newYork), newYork, 267},
+		{time.Date(2022, time.March, 14, 0, 30, 0, 0, newYork), newYork, 268},
+	}
+	for _, tt := range tests {
+		if got := PuzzleNumber(tt.t, Epoch, tt.loc); got != tt.want {
+			t.Errorf("PuzzleNumber(%s in %s) = %d, want %d", tt.t.Format(time.RFC3339), tt.loc, got, tt.want)
+		}
+	}
+
+	// an epoch given in another zone still starts on its calendar day
+	epoch := time.Date(2021, time.June, 19, 0, 0, 0, 0, east)
+	if got := PuzzleNumber(utc(time.June, 19, 12, 0), epoch, time.UTC); got != 0 {
+		t.Errorf("PuzzleNumber with an epoch in %s = %d, want 0", east, got)
+	}
+
+	if got := PuzzleDate(0, Epoch); !got.Equal(Epoch) {
+		t.Errorf("PuzzleDate(0) = %s, want the epoch", got)
+	}
+	if got := PuzzleDate(268, Epoch); PuzzleNumber(got, Epoch, time.UTC) != 268 {
+		t.Errorf("PuzzleDate(268) = %s, which is puzzle %d", got, PuzzleNumber(got, Epoch, time.UTC))
+	}
+}
+
+func TestPuzzleWord(t *testing.T) {
+	// puzzle 0 is the first word of the original Wordle
+	if got := puzzleWord(WordLength, 0); got != "cigar" {
+		t.Errorf("puzzleWord(5, 0) = %q, want cigar", got)
+	}
+	for length := MinLength; length <= MaxLength; length++ {
+		answers := Answers(length)
+		n := len(answers)
+		for _, tt := range []struct {
+			number int
+			want   string
+		}{
+			{0, answers[0]},
+			{1, answers[1]},
+			{n - 1, answers[n-1]},
+			{n, answers[0]},
+			{-1, answers[n-1]},
+		} {
+			if got := puzzleWord(length, tt.number); got != tt.want {
+				t.Errorf("puzzleWord(%d, %d) = %q, want %q", length, tt.number, got, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package wordle
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TileState is the feedback shown for a single letter of a guess
+type TileState int
+
+const (
+	Absent  TileState = iota // letter is not in the target (gray)
+	Present                  // letter is in the target but in another spot (yellow)
+	Correct                  // letter is in the correct spot (green)
+)
+
+func (t TileState) String() string {
+	switch t {
+	case Correct:
+		return "G"
+	case Present:
+		return "Y"
+	default:
+		return "B"
+	}
+}
+
+// Feedback holds one tile state per letter of a guess
+type Feedback []TileState
+
+// String returns the feedback in the compact form used by bots, e.g. "GYBBG"
+func (f Feedback) String() string {
+	b := make([]byte, len(f))
+	for i, t := range f {
+		b[i] = t.String()[0]
+	}
+	return string(b)
+}
+
+// ParseFeedback reads feedback in the form returned by String. Lower case
+// letters are accepted too.
+func ParseFeedback(s string) (Feedback, error) {
+	feedback := make(Feedback, len(s))
+	for i, c := range strings.ToUpper(s) {
+		switch c {
+		case 'G':
+			feedback[i] = Correct
+		case 'Y':
+			feedback[i] = Present
+		case 'B':
+			feedback[i] = Absent
+		default:
+			return nil, fmt.Errorf("invalid feedback %q, expected only G, Y and B", s)
+		}
+	}
+	return feedback, nil
+}
+
+// Solved reports whether every tile in the feedback is correct
+func (f Feedback) Solved() bool {
+	if len(f) == 0 {
+		return false
+	}
+	for _, t := range f {
+		if t != Correct {
+			return false
+		}
+	}
+	return true
+}
+
+// Code packs the feedback into a single number by reading the tiles as the
+// digits of a base 3 number, first tile lowest. Solvers use it to group
+// targets by the feedback a guess would get.
+func (f Feedback) Code() int {
+	code := 0
+	for i := len(f) - 1; i >= 0; i-- {
+		code = code*3 + int(f[i])
+	}
+	return code
+}
+
+// Score compares guess against target the same way NYT Wordle does. Greens are
+// handed out first, then yellows from whatever letters of the target are left,
+// so a letter is never marked more times than it appears in the target.
+func Score(guess string, target string) Feedback {
+	feedback := make(Feedback, len(guess))
+	score(feedback, guess, target)
+	return feedback
+}
+
+// ScoreCode returns Score(guess, target).Code() without allocating, for
+// callers that score millions of pairs
+func ScoreCode(guess string, target string) int {
+	var buf [16]TileState
+	if len(guess) > len(buf) {
+		return Score(guess, target).Code()
+	}
+	feedback := Feedback(buf[:len(guess)])
+	score(feedback, guess, target)
+	return feedback.Code()
+}
+
+// score writes the feedback for guess into feedback, which must start out
+// all Absent
+func score(feedback Feedback, guess string, target string) {
+	// count the letters of the target that weren't matched exactly
+	var remaining [256]uint8
+	for i := 0; i < len(target); i++ {
+		if i < len(guess) && guess[i] == target[i] {
+			feedback[i] = Correct
+		} else {
+			remaining[target[i]]++
+		}
+	}
+
+	for i := 0; i < len(guess); i++ {
+		if feedback[i] == Correct {
+			continue
+		}
+		if remaining[guess[i]] > 0 {
+			feedback[i] = Present
+			remaining[guess[i]]--
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package wordle
+
+import "testing"
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		guess, target string
+		want          string
+	}{
+		{"crane", "crane", "GGGGG"},
+		{"fjord", "crane", "BBBYB"},
+		// only one e in the target, so only one of the guess's gets a color
+		{"speed", "abide", "BBYBY"},
+		// the green b uses up one of the target's b's, leaving one for a yellow
+		{"abbey", "kebab", "YYGYB"},
+		// a green takes the letter even when a yellow comes first
+		{"hello", "world", "BBBGY"},
+		{"geese", "eerie", "BGYBG"},
+		{"dogs", "gods", "YGYG"},
+		{"playing", "parting", "GBYBGGG"},
+	}
+	for _, tt := range tests {
+		got := Score(tt.guess, tt.target)
+		if got.String() != tt.want {
+			t.Errorf("Score(%q, %q) = %s, want %s", tt.guess, tt.target, got, tt.want)
+		}
+		if code := ScoreCode(tt.guess, tt.target); code != got.Code() {
+			t.Errorf("ScoreCode(%q, %q) = %d, want Score().Code() = %d", tt.guess, tt.target, code, got.Code())
+		}
+	}
+}
+
+func TestScoreCodeMatchesScore(t *testing.T) {
+	words := wordLists[WordLength].answers[:200]
+	for _, guess := range words {
+		for _, target := range words {
+			if ScoreCode(guess, target) != Score(guess, target).Code() {
+				t.Fatalf("ScoreCode(%q, %q) doesn't match Score", guess, target)
+			}
+		}
+	}
+}
+
+func TestParseFeedback(t *testing.T) {
+	for _, s := range []string{"GYBBG", "BBBBB", "GGGG", "YBGYBGYB"} {
+		feedback, err := ParseFeedback(s)
+		if err != nil {
+			t.Fatalf("ParseFeedback(%q): %v", s, err)
+		}
+		if feedback.String() != s {
+			t.Errorf("ParseFeedback(%q).String() = %s", s, feedback)
+		}
+	}
+	if feedback, err := ParseFeedback("gybbg"); err != nil || feedback.String() != "GYBBG" {
+		t.Errorf("ParseFeedback(\"gybbg\") = %v, %v, want GYBBG", feedback, err)
+	}
+	if _, err := ParseFeedback("GYXBG"); err == nil {
+		t.Error("ParseFeedback(\"GYXBG\") should fail")
+	}
+	if !Score("crane", "crane").Solved() || Score("crane", "crate").Solved() {
+		t.Error("Solved should only be true when every tile is correct")
+	}
+}
@@ -0,0 +1,198 @@
+package wordle
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Game is one or more boards played with the same guesses, like Dordle and
+// Quordle. Every guess goes to each board that hasn't been solved yet, and
+// solved boards keep the rows they were solved with. A game with a single
+// board plays exactly like its Wordle.
+type Game struct {
+	boards  []*Wordle
+	guesses int
+	state   State
+}
+
+// DefaultTries returns the usual number of tries for a game with the given
+// number of boards: MaxGuesses for a single board and 5 more than the number
+// of boards otherwise
+func DefaultTries(boards int) int {
+	if boards == 1 {
+		return MaxGuesses
+	}
+	return boards + 5
+}
+
+// NewGame starts a game on the given number of boards. Every board is created
+// with opts, but gets its own target. Unless opts say otherwise, the game
+// allows DefaultTries guesses.
+func NewGame(boards int, opts ...Option) *Game {
+	if boards < 1 {
+		panic(fmt.Sprintf("wordle: a game needs at least one board, not %d", boards))
+	}
+	opts = append([]Option{WithTries(DefaultTries(boards))}, opts...)
+
+	g := &Game{boards: make([]*Wordle, boards), state: Playing}
+	for i := range g.boards {
+		g.boards[i] = New(opts...)
+		g.boards[i].seedLiar(i)
+	}
+	// adversarial boards don't have a target to pick
+	if boards > 1 && g.Mode() != Adversarial {
+		g.pickTargets()
+	}
+	return g
+}
+
+// pickTargets gives every board a different target. Daily boards use
+// consecutive puzzles starting from boards*puzzle, so every day gets new
+// words; practice boards draw from the first board's random source.
+func (g *Game) pickTargets() {
+	first := g.boards[0]
+	used := make(map[string]bool)
+	for i, b := range g.boards {
+		if first.mode == Daily {
+			b.target = puzzleWord(b.length, first.puzzle*len(g.boards)+i)
+		} else {
+			for b.target = getWord(first.rng, b.length); used[b.target]; {
+				b.target = getWord(first.rng, b.length)
+			}
+		}
+		used[b.target] = true
+	}
+}
+
+// Check reports why word would be rejected by Guess without submitting it.
+// The word has to be accepted by every board that is still being played.
+func (g *Game) Check(word string) error {
+	if g.state != Playing {
+		return ErrGameOver
+	}
+	for _, b := range g.boards {
+		if b.Over() {
+			continue
+		}
+		if err := b.Check(word); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Guess submits word to every board that hasn't been solved yet and returns
+// the feedback of each board, which is nil for boards that were already
+// solved. The game is won once every board is solved and lost when the tries
+// run out first.
+func (g *Game) Guess(word string) ([]Feedback, error) {
+	word = strings.ToLower(word)
+	if err := g.Check(word); err != nil {
+		return nil, err
+	}
+
+	feedback := make([]Feedback, len(g.boards))
+	won := true
+	for i, b := range g.boards {
+		if b.Over() {
+			continue
+		}
+		// the word was already checked against every board, so this can't fail
+		feedback[i], _ = b.Guess(word)
+		if b.State() != Won {
+			won = false
+		}
+		if b.State() == Lost {
+			g.state = Lost
+		}
+	}
+	g.guesses++
+	if won {
+		g.state = Won
+	}
+	return feedback, nil
+}
+
+// Boards returns the boards of the game in order
+func (g *Game) Boards() []*Wordle {
+	return g.boards
+}
+
+// Name returns what the variant played is called, like Wordle or Quordle
+func (g *Game) Name() string {
+	switch len(g.boards) {
+	case 1:
+		return g.boards[0].name()
+	case 2:
+		return "Dordle"
+	case 4:
+		return "Quordle"
+	case 8:
+		return "Octordle"
+	default:
+		return fmt.Sprintf("Wordle x%d", len(g.boards))
+	}
+}
+
+// Guesses returns the number of guesses made so far
+func (g *Game) Guesses() int {
+	return g.guesses
+}
+
+func (g *Game) State() State {
+	return g.state
+}
+
+// Over reports whether the game has been won or lost
+func (g *Game) Over() bool {
+	return g.state != Playing
+}
+
+// UseHint records that the player asked for help
+func (g *Game) UseHint() {
+	for _, b := range g.boards {
+		b.UseHint()
+	}
+}
+
+// The settings below are the same on every board, so they're read from the
+// first one.
+
+// Hints returns the number of hints used so far
+func (g *Game) Hints() int {
+	return g.boards[0].Hints()
+}
+
+// Lies returns the number of tiles that lie in every row of every board
+func (g *Game) Lies() int {
+	return g.boards[0].Lies()
+}
+
+// Tries returns the number of guesses allowed, or Unlimited
+func (g *Game) Tries() int {
+	return g.boards[0].Tries()
+}
+
+// Length returns the number of letters in the targets
+func (g *Game) Length() int {
+	return g.boards[0].Length()
+}
+
+func (g *Game) Difficulty() Difficulty {
+	return g.boards[0].Difficulty()
+}
+
+func (g *Game) Mode() Mode {
+	return g.boards[0].Mode()
+}
+
+// Puzzle returns the number of the daily puzzle being played. It is only
+// meaningful when Mode is Daily.
+func (g *Game) Puzzle() int {
+	return g.boards[0].Puzzle()
+}
+
+// Seed returns the seed the game's random choices were made from
+func (g *Game) Seed() int64 {
+	return g.boards[0].Seed()
+}
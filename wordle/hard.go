@@ -0,0 +1,138 @@
+package wordle
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Difficulty controls which revealed hints a guess is forced to respect
+type Difficulty int
+
+const (
+	// Normal accepts any word in the dictionary
+	Normal Difficulty = iota
+	// Hard requires green letters to stay in place and yellow letters to be reused
+	Hard
+	// UltraHard also forbids gray letters and moving a yellow letter back to a
+	// spot where it was already shown to be wrong
+	UltraHard
+)
+
+func (d Difficulty) String() string {
+	switch d {
+	case Hard:
+		return "hard"
+	case UltraHard:
+		return "ultra hard"
+	default:
+		return "normal"
+	}
+}
+
+// ErrHardMode is matched by every HardModeError through errors.Is
+var ErrHardMode = errors.New("guess breaks a hard mode rule")
+
+// Rule is a hard mode constraint that a guess can violate
+type Rule int
+
+const (
+	MustPlace    Rule = iota // a green letter has to stay in its spot
+	MustInclude              // a yellow letter has to be used somewhere
+	MustNotPlace             // a yellow letter can't go back to the same spot (ultra hard)
+	MustExclude              // a gray letter can't be used again (ultra hard)
+)
+
+// HardModeError describes the first hint a guess failed to respect
+type HardModeError struct {
+	Rule     Rule
+	Letter   byte
+	Position int // index of the offending spot, or -1 when the rule isn't about a spot
+}
+
+func (e *HardModeError) Error() string {
+	letter := strings.ToUpper(string(e.Letter))
+	switch e.Rule {
+	case MustPlace:
+		return fmt.Sprintf("%s letter must be %s", ordinal(e.Position+1), letter)
+	case MustInclude:
+		return fmt.Sprintf("Guess must contain %s", letter)
+	case MustNotPlace:
+		return fmt.Sprintf("%s letter can't be %s", ordinal(e.Position+1), letter)
+	default:
+		return fmt.Sprintf("Guess can't contain another %s", letter)
+	}
+}
+
+func (e *HardModeError) Is(target error) bool {
+	return target == ErrHardMode
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
+
+// CheckHints makes sure word respects the hints revealed by rows for the given
+// difficulty, returning a *HardModeError for the first one it doesn't. Solvers
+// use it to only suggest guesses a hard mode game would accept.
+func CheckHints(word string, rows []Row, difficulty Difficulty) error {
+	if difficulty == Normal {
+		return nil
+	}
+	for _, row := range rows {
+		// greens have to stay where they are
+		for i, t := range row.Feedback {
+			if t == Correct && word[i] != row.Word[i] {
+				return &HardModeError{Rule: MustPlace, Letter: row.Word[i], Position: i}
+			}
+		}
+
+		// count how many of each letter the row proved to be in the target
+		var known [256]int
+		var ruledOut [256]bool
+		for i, t := range row.Feedback {
+			if t == Absent {
+				ruledOut[row.Word[i]] = true
+			} else {
+				known[row.Word[i]]++
+			}
+		}
+		var used [256]int
+		for i := 0; i < len(word); i++ {
+			used[word[i]]++
+		}
+
+		// yellows have to be reused, in the order they appear in the row
+		for i, t := range row.Feedback {
+			if t == Present && used[row.Word[i]] < known[row.Word[i]] {
+				return &HardModeError{Rule: MustInclude, Letter: row.Word[i], Position: -1}
+			}
+		}
+
+		if difficulty < UltraHard {
+			continue
+		}
+		for i, t := range row.Feedback {
+			if t == Present && word[i] == row.Word[i] {
+				return &HardModeError{Rule: MustNotPlace, Letter: row.Word[i], Position: i}
+			}
+		}
+		// a gray letter means the target has exactly as many of that letter as
+		// the row showed in green or yellow, which is usually none
+		for i := 0; i < len(word); i++ {
+			if ruledOut[word[i]] && used[word[i]] > known[word[i]] {
+				return &HardModeError{Rule: MustExclude, Letter: word[i], Position: -1}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package wordle
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckHints(t *testing.T) {
+	row := func(word, feedback string) Row {
+		f, err := ParseFeedback(feedback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return Row{Word: word, Feedback: f}
+	}
+	// A is green in the middle, E is yellow at the end and C, R and N are gray
+	crane := []Row{row("crane", "BBGBY")}
+	// one E is green and the other yellow
+	sleep := []Row{row("sleep", "BBGYB")}
+	// the target has exactly one E, at the start
+	eerie := []Row{row("eerie", "GBBBB")}
+
+	tests := []struct {
+		word       string
+		rows       []Row
+		difficulty Difficulty
+		want       *HardModeError
+		message    string
+	}{
+		{"xxxxx", crane, Normal, nil, ""},
+		{"beast", crane, Hard, nil, ""},
+		{"beast", crane, UltraHard, nil, ""},
+		{"sheaf", crane, Hard, &HardModeError{Rule: MustPlace, Letter: 'a', Position: 2}, "3rd letter must be A"},
+		{"plaid", crane, Hard, &HardModeError{Rule: MustInclude, Letter: 'e', Position: -1}, "Guess must contain E"},
+		{"there", sleep, Hard, nil, ""},
+		{"cheap", sleep, Hard, &HardModeError{Rule: MustInclude, Letter: 'e', Position: -1}, "Guess must contain E"},
+		// a yellow letter can go back to the same spot unless it's ultra hard
+		{"plate", crane, Hard, nil, ""},
+		{"plate", crane, UltraHard, &HardModeError{Rule: MustNotPlace, Letter: 'e', Position: 4}, "5th letter can't be E"},
+		// and so can gray letters
+		{"enact", crane, Hard, nil, ""},
+		{"enact", crane, UltraHard, &HardModeError{Rule: MustExclude, Letter: 'n', Position: -1}, "Guess can't contain another N"},
+		{"eagle", eerie, Hard, nil, ""},
+		{"eagle", eerie, UltraHard, &HardModeError{Rule: MustExclude, Letter: 'e', Position: -1}, "Guess can't contain another E"},
+		// every row is checked
+		{"beast", append(crane, row("bloat", "GBYBB")), Hard, &HardModeError{Rule: MustInclude, Letter: 'o', Position: -1}, "Guess must contain O"},
+	}
+	for _, tt := range tests {
+		err := CheckHints(tt.word, tt.rows, tt.difficulty)
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("CheckHints(%q, %v) = %v, want nil", tt.word, tt.difficulty, err)
+			}
+			continue
+		}
+		var hardErr *HardModeError
+		if !errors.As(err, &hardErr) || *hardErr != *tt.want {
+			t.Errorf("CheckHints(%q, %v) = %#v, want %#v", tt.word, tt.difficulty, err, tt.want)
+			continue
+		}
+		if !errors.Is(err, ErrHardMode) {
+			t.Errorf("CheckHints(%q, %v) = %v, which doesn't match ErrHardMode", tt.word, tt.difficulty, err)
+		}
+		if err.Error() != tt.message {
+			t.Errorf("CheckHints(%q, %v) says %q, want %q", tt.word, tt.difficulty, err, tt.message)
+		}
+	}
+}
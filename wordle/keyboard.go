@@ -0,0 +1,60 @@
+package wordle
+
+// KeyState is the best known state of a letter of the keyboard. States are
+// ordered by how much they say about the letter, and a key's state is never
+// lowered by a later guess.
+type KeyState int
+
+const (
+	KeyUnknown KeyState = iota // the letter hasn't been guessed yet
+	KeyAbsent                  // the letter isn't in the target
+	KeyPresent                 // the letter is in the target
+	KeyCorrect                 // the letter was placed in its spot at least once
+)
+
+// Keyboard holds the state of every letter from a to z
+type Keyboard [26]KeyState
+
+// Update records the feedback of a guess. Every tile raises the state of its
+// letter to the tile's state if that's higher, so a letter stays green once
+// it was green and a repeated letter is yellow if any of its tiles were. For
+// example "speed" against "abide" leaves E yellow even though its second
+// tile is gray.
+func (k *Keyboard) Update(row Row) {
+	for i := 0; i < len(row.Word) && i < len(row.Feedback); i++ {
+		letter := row.Word[i] - 'a'
+		if int(letter) >= len(k) {
+			continue
+		}
+		state := KeyAbsent
+		switch row.Feedback[i] {
+		case Correct:
+			state = KeyCorrect
+		case Present:
+			state = KeyPresent
+		}
+		if state > k[letter] {
+			k[letter] = state
+		}
+	}
+}
+
+// State returns the state of a letter, given in upper or lower case
+func (k *Keyboard) State(letter rune) KeyState {
+	if letter >= 'A' && letter <= 'Z' {
+		letter += 'a' - 'A'
+	}
+	if letter < 'a' || letter > 'z' {
+		return KeyUnknown
+	}
+	return k[letter-'a']
+}
+
+// Keyboard returns the state of every letter after the guesses made so far
+func (w *Wordle) Keyboard() Keyboard {
+	var k Keyboard
+	for _, row := range w.rows {
+		k.Update(row)
+	}
+	return k
+}
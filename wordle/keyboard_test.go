@@ -0,0 +1,54 @@
+package wordle
+
+import "testing"
+
+func TestKeyboard(t *testing.T) {
+	tests := []struct {
+		target  string
+		guesses []string
+		want    map[rune]KeyState
+	}{
+		// the second e is gray, but the first one already showed it's there
+		{"abide", []string{"speed"}, map[rune]KeyState{
+			's': KeyAbsent, 'p': KeyAbsent, 'e': KeyPresent, 'd': KeyPresent, 'a': KeyUnknown,
+		}},
+		// a green letter stays green when a later guess shows it in the wrong spot
+		{"abide", []string{"abort", "bread"}, map[rune]KeyState{
+			'a': KeyCorrect, 'b': KeyCorrect, 'r': KeyAbsent, 'e': KeyPresent, 'd': KeyPresent,
+		}},
+		// a yellow letter turns green once it's placed
+		{"itchy", []string{"chant", "itchy"}, map[rune]KeyState{
+			'c': KeyCorrect, 'h': KeyCorrect, 't': KeyCorrect, 'a': KeyAbsent, 'n': KeyAbsent,
+		}},
+		// a yellow letter doesn't turn gray when a repeat of it is gray
+		{"abide", []string{"dread", "added"}, map[rune]KeyState{
+			'd': KeyPresent, 'a': KeyCorrect, 'e': KeyPresent, 'r': KeyAbsent,
+		}},
+	}
+	for _, tt := range tests {
+		w := New(WithTries(Unlimited))
+		w.target = tt.target
+		for _, guess := range tt.guesses {
+			if _, err := w.Guess(guess); err != nil {
+				t.Fatalf("guessing %q: %v", guess, err)
+			}
+		}
+		k := w.Keyboard()
+		for letter, want := range tt.want {
+			if got := k.State(letter); got != want {
+				t.Errorf("after %v against %q, %c is %d, want %d", tt.guesses, tt.target, letter, got, want)
+			}
+		}
+	}
+}
+
+func TestKeyboardState(t *testing.T) {
+	var k Keyboard
+	k.Update(Row{Word: "speed", Feedback: Score("speed", "abide")})
+	if k.State('E') != KeyPresent || k.State('e') != KeyPresent {
+		t.Error("State should give the same state for upper and lower case letters")
+	}
+	if k.State('1') != KeyUnknown || k.State('é') != KeyUnknown {
+		t.Error("State of a character that isn't a letter should be KeyUnknown")
+	}
+}
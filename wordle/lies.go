@@ -0,0 +1,57 @@
+package wordle
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// WithLies makes the game lie, like Fibble: every guess that doesn't match
+// the target gets feedback with exactly n tiles showing the wrong state.
+// Which tiles lie and what they show is worked out from the seed of the game,
+// or the puzzle number of a daily game, so replaying a game gives the same
+// lies. Games created WithSource draw the seed of their lies from the source.
+// Hard mode rules aren't enforced in games that lie, since the hints they
+// would be based on can't be trusted. New panics if n is more than the
+// number of letters in the words.
+func WithLies(n int) Option {
+	return func(w *Wordle) {
+		w.lies = n
+	}
+}
+
+// Lies returns the number of tiles that lie in every row
+func (w *Wordle) Lies() int {
+	return w.lies
+}
+
+// seedLiar starts the sequence lies are drawn from. Every board of a game
+// gets its own sequence.
+func (w *Wordle) seedLiar(board int) {
+	if w.lies < 0 || w.lies > w.length {
+		panic(fmt.Sprintf("wordle: can't lie about %d tiles of %d letter words", w.lies, w.length))
+	}
+	seed := w.seed
+	switch {
+	case w.mode == Daily:
+		seed = int64(w.puzzle)
+	case !w.seeded:
+		seed = w.liarSeed
+	}
+	w.liar = rand.New(rand.NewSource(seed ^ int64(board)<<32))
+}
+
+// lie changes the state of w.lies different tiles of feedback to one of the
+// other two states. feedback is for a guess that isn't the target, so lies
+// that would make it look solved are drawn again.
+func (w *Wordle) lie(feedback Feedback) {
+	truth := append(Feedback(nil), feedback...)
+	for {
+		for _, i := range w.liar.Perm(len(feedback))[:w.lies] {
+			feedback[i] = (truth[i] + 1 + TileState(w.liar.Intn(2))) % 3
+		}
+		if !feedback.Solved() {
+			return
+		}
+		copy(feedback, truth)
+	}
+}
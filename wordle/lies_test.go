@@ -0,0 +1,36 @@
+package wordle
+
+import "testing"
+
+func TestLiesNeverSolve(t *testing.T) {
+	w := New(WithSeed(11), WithLies(1))
+	if w.Target() != "quack" {
+		t.Fatalf("target of seed 11 = %q, want quack", w.Target())
+	}
+	if feedback, err := w.Guess("quark"); err != nil || feedback.Solved() {
+		t.Errorf("quark against quack with a lie = %v, %v, want feedback that isn't solved", feedback, err)
+	}
+
+	// a row that doesn't solve the puzzle must never come back all green,
+	// whichever tiles lie
+	for length := MinLength; length <= MaxLength; length++ {
+		guesses := Answers(length)[:50]
+		for lies := 1; lies <= length; lies++ {
+			for seed := int64(0); seed < 20; seed++ {
+				w := New(WithSeed(seed), WithLength(length), WithLies(lies), WithTries(Unlimited))
+				for _, guess := range guesses {
+					if guess == w.Target() {
+						continue
+					}
+					feedback, err := w.Guess(guess)
+					if err != nil {
+						t.Fatalf("seed %d, %d lies: guessing %q: %v", seed, lies, guess, err)
+					}
+					if feedback.Solved() || w.Over() {
+						t.Fatalf("seed %d, %d lies: %q against %q came back %s", seed, lies, guess, w.Target(), feedback)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package wordle
+
+import "sort"
+
+// MinLength and MaxLength bound the word lengths there are word lists for
+const MinLength = 4
+const MaxLength = 8
+
+// wordList holds the answers and accepted guesses for one word length
+type wordList struct {
+	answers []string
+	legal   map[string]bool
+}
+
+var wordLists = map[int]wordList{
+	WordLength: {answers: words, legal: LegalWords},
+}
+
+// The guesses accepted for the other lengths are the answers, a few hundred
+// words picked by hand, and every word of that length in:
+//
+//   - the EFF large diceware word list
+//   - the nouns and verbs in gofakeit's word list, and their plural and third
+//     person forms
+//   - the 20,000 most common words of the US TV and film frequency list
+//     shipped with zxcvbn and the correct spellings in misspell's dictionary
+//     of common misspellings, but only the ones that are regular inflections
+//     of the words above or that are mostly written in lower case in the
+//     prose of the Go, Python and Rust documentation
+//
+// Names of people, places, products, days and months are then left out, so
+// the subtitles and brand names in the frequency lists aren't guesses.
+func init() {
+	// the other lengths only list the guesses that aren't answers, so the
+	// answers are added to them here
+	for length, lists := range map[int][2][]string{
+		4: {words4, extraWords4},
+		6: {words6, extraWords6},
+		7: {words7, extraWords7},
+		8: {words8, extraWords8},
+	} {
+		legal := make(map[string]bool, len(lists[0])+len(lists[1]))
+		for _, list := range lists {
+			for _, word := range list {
+				legal[word] = true
+			}
+		}
+		wordLists[length] = wordList{answers: lists[0], legal: legal}
+	}
+}
+
+// ValidLength reports whether there are word lists for words of the given
+// length
+func ValidLength(length int) bool {
+	_, ok := wordLists[length]
+	return ok
+}
+
+// IsLegal reports whether word is accepted as a guess
+func IsLegal(word string) bool {
+	return wordLists[len(word)].legal[word]
+}
+
+// Answers returns a copy of the list daily and practice targets of the given
+// length are picked from
+func Answers(length int) []string {
+	return append([]string(nil), wordLists[length].answers...)
+}
+
+// LegalGuesses returns every word of the given length accepted as a guess, in
+// alphabetical order
+func LegalGuesses(length int) []string {
+	legal := wordLists[length].legal
+	guesses := make([]string, 0, len(legal))
+	for word := range legal {
+		guesses = append(guesses, word)
+	}
+	sort.Strings(guesses)
+	return guesses
+}
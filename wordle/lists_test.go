@@ -0,0 +1,56 @@
+package wordle
+
+import "testing"
+
+func TestIsLegal(t *testing.T) {
+	for _, word := range []string{"dogs", "cats", "crane", "purple", "things", "playing", "kitchen", "mountain", "birthday"} {
+		if !IsLegal(word) {
+			t.Errorf("IsLegal(%q) = false, want true", word)
+		}
+	}
+	for _, word := range []string{"xxxx", "talkin", "qwertyu", "abcdefgh", "abc", "abcdefghi"} {
+		if IsLegal(word) {
+			t.Errorf("IsLegal(%q) = true, want false", word)
+		}
+	}
+}
+
+// proper nouns, acronyms and made up words that were once in the frequency
+// lists the extra guesses come from
+func TestIsLegalNames(t *testing.T) {
+	for _, word := range []string{
+		"iraq", "nypd", "juno", "nate", "alvy", "groo", "atat", "john",
+		"lawrence", "achilles", "blizzcon", "hartmans", "havesham", "kynaston",
+		"london", "facebook", "thursday", "october",
+	} {
+		if IsLegal(word) {
+			t.Errorf("IsLegal(%q) = true, want false", word)
+		}
+	}
+}
+
+func TestWordLists(t *testing.T) {
+	for length := MinLength; length <= MaxLength; length++ {
+		seen := make(map[string]bool)
+		for _, word := range Answers(length) {
+			if len(word) != length || !IsLegal(word) {
+				t.Errorf("answer %q isn't a legal %d letter guess", word, length)
+			}
+			if seen[word] {
+				t.Errorf("answer %q is listed twice", word)
+			}
+			seen[word] = true
+		}
+		for _, word := range LegalGuesses(length) {
+			if len(word) != length {
+				t.Errorf("guess %q is in the %d letter list", word, length)
+			}
+			for _, c := range word {
+				if c < 'a' || c > 'z' {
+					t.Errorf("guess %q has a character other than a to z", word)
+					break
+				}
+			}
+		}
+	}
+}
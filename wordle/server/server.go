@@ -0,0 +1,336 @@
+// Package server serves games over a JSON HTTP API:
+//
+//	POST /games               starts a game and returns it, with its id
+//	POST /games/{id}/guesses  makes a guess, sent as {"word": "crane"}
+//	GET  /games/{id}          returns a game
+//
+// Games are kept in memory and forgotten once they haven't been used for a
+// while. Their targets are only given away once they're over.
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// requests bodies are small, so anything bigger than this is refused
+const maxBodySize = 1 << 16
+
+// Server is an http.Handler serving the API. It is safe for concurrent use.
+type Server struct {
+	ttl time.Duration
+	// now tells the time games were last used, and can be replaced by tests
+	now func() time.Time
+
+	mu    sync.Mutex
+	games map[string]*entry
+}
+
+type entry struct {
+	game     *wordle.Wordle
+	lastUsed time.Time
+}
+
+// New returns a server that forgets games ttl after the last request made
+// for them
+func New(ttl time.Duration) *Server {
+	return &Server{ttl: ttl, now: time.Now, games: make(map[string]*entry)}
+}
+
+// Game is how a game is shown in responses
+type Game struct {
+	ID         string `json:"id"`
+	Mode       string `json:"mode"`
+	Puzzle     *int   `json:"puzzle,omitempty"`
+	Length     int    `json:"length"`
+	Tries      int    `json:"tries"`
+	Difficulty string `json:"difficulty"`
+	Lies       int    `json:"lies,omitempty"`
+	State      string `json:"state"`
+	Guesses    []Row  `json:"guesses"`
+	// Target is only set once the game is over
+	Target string `json:"target,omitempty"`
+}
+
+// Row is a guess and the feedback it got, like GYBBG
+type Row struct {
+	Word     string `json:"word"`
+	Feedback string `json:"feedback"`
+}
+
+// NewGame is the body of a request to start a game. Every field is optional:
+// the defaults give a practice game like the NYT's.
+type NewGame struct {
+	Length int `json:"length"`
+	// Tries is the number of guesses allowed, or 0 for no limit
+	Tries *int `json:"tries"`
+	// Difficulty is "normal", "hard" or "ultra"
+	Difficulty string `json:"difficulty"`
+	// Seed picks the target of a practice game, and Puzzle makes the game the
+	// daily puzzle with that number. Only one of them can be given.
+	Seed        *int64 `json:"seed"`
+	Puzzle      *int   `json:"puzzle"`
+	Adversarial bool   `json:"adversarial"`
+	Lies        int    `json:"lies"`
+}
+
+// Guess is the body of a request to make a guess
+type Guess struct {
+	Word string `json:"word"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// routes are /games, /games/{id} and /games/{id}/guesses
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] != "games" || len(parts) > 3 || len(parts) == 3 && parts[2] != "guesses" {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	var method string
+	switch len(parts) {
+	case 1:
+		method = http.MethodPost
+	case 2:
+		method = http.MethodGet
+	case 3:
+		method = http.MethodPost
+	}
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("%s isn't allowed here", r.Method))
+		return
+	}
+
+	switch len(parts) {
+	case 1:
+		s.create(w, r)
+	case 2:
+		s.get(w, parts[1])
+	case 3:
+		s.guess(w, r, parts[1])
+	}
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request) {
+	var req NewGame
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	opts, err := req.options()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	id, err := newID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expire()
+	e := &entry{game: wordle.New(opts...), lastUsed: s.now()}
+	s.games[id] = e
+
+	w.Header().Set("Location", "/games/"+id)
+	writeJSON(w, http.StatusCreated, view(id, e.game))
+}
+
+func (s *Server) get(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.lookup(id)
+	if e == nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no game %q", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, view(id, e.game))
+}
+
+func (s *Server) guess(w http.ResponseWriter, r *http.Request, id string) {
+	var req Guess
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.lookup(id)
+	if e == nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no game %q", id))
+		return
+	}
+	if _, err := e.game.Guess(req.Word); err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, wordle.ErrGameOver) {
+			status = http.StatusConflict
+		}
+		writeError(w, status, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, view(id, e.game))
+}
+
+// lookup returns the game with the given id and marks it as used, or nil if
+// there is none. s.mu must be held.
+func (s *Server) lookup(id string) *entry {
+	e, ok := s.games[id]
+	if !ok {
+		return nil
+	}
+	now := s.now()
+	if now.Sub(e.lastUsed) > s.ttl {
+		delete(s.games, id)
+		return nil
+	}
+	e.lastUsed = now
+	return e
+}
+
+// expire forgets every game that hasn't been used for longer than the TTL.
+// It's run whenever a game is added, so expired games never pile up. s.mu
+// must be held.
+func (s *Server) expire() {
+	now := s.now()
+	for id, e := range s.games {
+		if now.Sub(e.lastUsed) > s.ttl {
+			delete(s.games, id)
+		}
+	}
+}
+
+// options returns the options of the game the request asks for
+func (req NewGame) options() ([]wordle.Option, error) {
+	var opts []wordle.Option
+	if req.Length != 0 {
+		if !wordle.ValidLength(req.Length) {
+			return nil, fmt.Errorf("length must be between %d and %d", wordle.MinLength, wordle.MaxLength)
+		}
+		opts = append(opts, wordle.WithLength(req.Length))
+	}
+	length := req.Length
+	if length == 0 {
+		length = wordle.WordLength
+	}
+
+	if req.Tries != nil {
+		switch {
+		case *req.Tries < 0:
+			return nil, errors.New("tries can't be negative")
+		case *req.Tries == 0:
+			opts = append(opts, wordle.WithTries(wordle.Unlimited))
+		default:
+			opts = append(opts, wordle.WithTries(*req.Tries))
+		}
+	}
+
+	if req.Lies > 0 && req.Difficulty != "" && req.Difficulty != "normal" {
+		return nil, errors.New("lies can't be used with the hard or ultra difficulty")
+	}
+	switch req.Difficulty {
+	case "", "normal":
+	case "hard":
+		opts = append(opts, wordle.WithDifficulty(wordle.Hard))
+	case "ultra":
+		opts = append(opts, wordle.WithDifficulty(wordle.UltraHard))
+	default:
+		return nil, fmt.Errorf("unknown difficulty %q, expected normal, hard or ultra", req.Difficulty)
+	}
+
+	modes := 0
+	if req.Seed != nil {
+		modes++
+		opts = append(opts, wordle.WithSeed(*req.Seed))
+	}
+	if req.Puzzle != nil {
+		modes++
+		if *req.Puzzle < 0 {
+			return nil, errors.New("puzzle can't be negative")
+		}
+		opts = append(opts, wordle.WithPuzzle(*req.Puzzle))
+	}
+	if req.Adversarial {
+		modes++
+		opts = append(opts, wordle.WithAdversary())
+	}
+	if modes > 1 {
+		return nil, errors.New("only one of seed, puzzle and adversarial can be given")
+	}
+
+	if req.Lies < 0 || req.Lies > length {
+		return nil, fmt.Errorf("lies must be between 0 and %d", length)
+	}
+	if req.Lies > 0 {
+		opts = append(opts, wordle.WithLies(req.Lies))
+	}
+	return opts, nil
+}
+
+// view returns what can be shown of a game without spoiling it
+func view(id string, w *wordle.Wordle) Game {
+	g := Game{
+		ID:         id,
+		Mode:       w.Mode().String(),
+		Length:     w.Length(),
+		Tries:      w.Tries(),
+		Difficulty: w.Difficulty().String(),
+		Lies:       w.Lies(),
+		State:      w.State().String(),
+		Guesses:    make([]Row, len(w.Rows())),
+	}
+	if w.Mode() == wordle.Daily {
+		puzzle := w.Puzzle()
+		g.Puzzle = &puzzle
+	}
+	for i, row := range w.Rows() {
+		g.Guesses[i] = Row{Word: row.Word, Feedback: row.Feedback.String()}
+	}
+	if w.Over() {
+		g.Target = w.Target()
+	}
+	return g
+}
+
+// newID returns a random id for a game, hard enough to guess that games can't
+// be found by anyone they weren't shared with
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// decode reads the JSON body of a request into v. An empty body leaves v as
+// it is.
+func decode(r *http.Request, v interface{}) error {
+	err := json.NewDecoder(io.LimitReader(r.Body, maxBodySize)).Decode(v)
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("invalid request body: %v", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sends a request to the server and decodes the game in its response, if
+// there is one
+func do(t *testing.T, s *Server, method, path, body string) (int, Game) {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	var g Game
+	if rec.Code < 300 {
+		if err := json.Unmarshal(rec.Body.Bytes(), &g); err != nil {
+			t.Fatalf("%s %s: invalid response %q: %v", method, path, rec.Body, err)
+		}
+	} else if !strings.Contains(rec.Body.String(), `"error"`) {
+		t.Errorf("%s %s: error response without an error: %q", method, path, rec.Body)
+	}
+	return rec.Code, g
+}
+
+func TestGame(t *testing.T) {
+	s := New(time.Hour)
+	status, g := do(t, s, http.MethodPost, "/games", `{"seed": 42}`)
+	if status != http.StatusCreated || g.ID == "" {
+		t.Fatalf("POST /games = %d with id %q, want 201 with an id", status, g.ID)
+	}
+	if g.Mode != "practice" || g.Length != 5 || g.Tries != 6 || g.State != "playing" || len(g.Guesses) != 0 {
+		t.Errorf("new game = %+v", g)
+	}
+	if g.Target != "" {
+		t.Errorf("target of a game in progress given away: %q", g.Target)
+	}
+
+	status, g = do(t, s, http.MethodPost, "/games/"+g.ID+"/guesses", `{"word": "crane"}`)
+	if status != http.StatusOK || len(g.Guesses) != 1 || g.Guesses[0] != (Row{Word: "crane", Feedback: "YBBBB"}) {
+		t.Errorf("guessing crane = %d %+v", status, g.Guesses)
+	}
+	if g.Target != "" {
+		t.Errorf("target given away after a guess: %q", g.Target)
+	}
+
+	status, got := do(t, s, http.MethodGet, "/games/"+g.ID, "")
+	if status != http.StatusOK || got.ID != g.ID || len(got.Guesses) != 1 {
+		t.Errorf("GET = %d %+v, want the game with its guess", status, got)
+	}
+
+	status, g = do(t, s, http.MethodPost, "/games/"+g.ID+"/guesses", `{"word": "itchy"}`)
+	if status != http.StatusOK || g.State != "won" || g.Target != "itchy" {
+		t.Errorf("winning guess = %d, state %q, target %q, want won with target itchy", status, g.State, g.Target)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	s := New(time.Hour)
+	_, g := do(t, s, http.MethodPost, "/games", `{"seed": 42, "tries": 1}`)
+	guesses := "/games/" + g.ID + "/guesses"
+
+	tests := []struct {
+		method, path, body string
+		want               int
+	}{
+		{http.MethodGet, "/nothing", "", http.StatusNotFound},
+		{http.MethodGet, "/games/" + g.ID + "/other", "", http.StatusNotFound},
+		{http.MethodGet, "/games/unknown", "", http.StatusNotFound},
+		{http.MethodPost, "/games/unknown/guesses", `{"word": "crane"}`, http.StatusNotFound},
+		{http.MethodGet, "/games", "", http.StatusMethodNotAllowed},
+		{http.MethodDelete, "/games/" + g.ID, "", http.StatusMethodNotAllowed},
+		{http.MethodGet, guesses, "", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/games", `{"length": 9}`, http.StatusBadRequest},
+		{http.MethodPost, "/games", `{"difficulty": "easy"}`, http.StatusBadRequest},
+		{http.MethodPost, "/games", `{"seed": 1, "puzzle": 1}`, http.StatusBadRequest},
+		{http.MethodPost, "/games", `{"lies": 6}`, http.StatusBadRequest},
+		{http.MethodPost, "/games", `{"lies": 1, "difficulty": "hard"}`, http.StatusBadRequest},
+		{http.MethodPost, "/games", `{"lies": 1, "difficulty": "ultra"}`, http.StatusBadRequest},
+		{http.MethodPost, "/games", `{"tries": -1}`, http.StatusBadRequest},
+		{http.MethodPost, "/games", `not json`, http.StatusBadRequest},
+		{http.MethodPost, guesses, `{"word": "xxxxx"}`, http.StatusUnprocessableEntity},
+		{http.MethodPost, guesses, `{"word": "crater"}`, http.StatusUnprocessableEntity},
+		// the only try is used up, so the game is lost
+		{http.MethodPost, guesses, `{"word": "crane"}`, http.StatusOK},
+		{http.MethodPost, guesses, `{"word": "crane"}`, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		if status, _ := do(t, s, tt.method, tt.path, tt.body); status != tt.want {
+			t.Errorf("%s %s %s = %d, want %d", tt.method, tt.path, tt.body, status, tt.want)
+		}
+	}
+
+	if _, g := do(t, s, http.MethodGet, "/games/"+g.ID, ""); g.State != "lost" || g.Target != "itchy" {
+		t.Errorf("lost game has state %q and target %q, want lost with target itchy", g.State, g.Target)
+	}
+}
+
+func TestLies(t *testing.T) {
+	s := New(time.Hour)
+	for _, body := range []string{`{"lies": 1}`, `{"lies": 2, "difficulty": "normal"}`} {
+		if status, g := do(t, s, http.MethodPost, "/games", body); status != http.StatusCreated || g.Lies == 0 || g.Difficulty != "normal" {
+			t.Errorf("POST /games %s = %d %+v, want 201 with lies", body, status, g)
+		}
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	s := New(100 * time.Millisecond)
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	_, g := do(t, s, http.MethodPost, "/games", "")
+
+	// every request keeps the game for another ttl
+	for i := 0; i < 3; i++ {
+		now = now.Add(100 * time.Millisecond)
+		if status, _ := do(t, s, http.MethodGet, "/games/"+g.ID, ""); status != http.StatusOK {
+			t.Fatalf("game in use expired: GET = %d", status)
+		}
+	}
+
+	now = now.Add(101 * time.Millisecond)
+	if status, _ := do(t, s, http.MethodGet, "/games/"+g.ID, ""); status != http.StatusNotFound {
+		t.Errorf("GET of an expired game = %d, want 404", status)
+	}
+
+	// games nobody asks for again are forgotten when another one starts
+	_, g = do(t, s, http.MethodPost, "/games", "")
+	now = now.Add(101 * time.Millisecond)
+	do(t, s, http.MethodPost, "/games", "")
+	if _, kept := s.games[g.ID]; kept {
+		t.Error("expired game kept after a new one started")
+	}
+}
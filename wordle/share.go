@@ -0,0 +1,131 @@
+package wordle
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Palette is the set of emoji squares used in a share grid
+type Palette int
+
+const (
+	Classic      Palette = iota // green and yellow, like the tiles
+	HighContrast                // orange and blue, for colorblind players
+)
+
+func (p Palette) squares() map[TileState]string {
+	if p == HighContrast {
+		return map[TileState]string{Correct: "🟧", Present: "🟦", Absent: "⬛"}
+	}
+	return map[TileState]string{Correct: "🟩", Present: "🟨", Absent: "⬛"}
+}
+
+// Share returns the spoiler free result of the game, a header line like
+// "Wordle 1234 4/6*" followed by one row of squares per guess. The asterisk
+// marks games played in hard mode, lost games show X instead of the number
+// of guesses and games won with help say how many hints were used.
+func (w *Wordle) Share(p Palette) string {
+	var b strings.Builder
+	writeHeader(&b, w.name(), w, len(w.rows), w.state == Won)
+
+	squares := p.squares()
+	for _, row := range w.rows {
+		b.WriteString("\n")
+		for _, t := range row.Feedback {
+			b.WriteString(squares[t])
+		}
+	}
+	return b.String()
+}
+
+// Share returns the spoiler free result of the game. A single board is shared
+// like a Wordle, more boards get a header like "Quordle 1234 8/9" followed by
+// the number of guesses each board took as keycap digits, with a red square
+// for boards that weren't solved.
+func (g *Game) Share(p Palette) string {
+	if len(g.boards) == 1 {
+		return g.boards[0].Share(p)
+	}
+
+	var b strings.Builder
+	writeHeader(&b, g.Name(), g.boards[0], g.guesses, g.state == Won)
+
+	perRow := 2
+	if len(g.boards) > 4 {
+		perRow = 4
+	}
+	for i, board := range g.boards {
+		if i%perRow == 0 {
+			b.WriteString("\n")
+		}
+		if board.state == Won {
+			b.WriteString(keycaps(len(board.rows)))
+		} else {
+			b.WriteString("🟥")
+		}
+	}
+	return b.String()
+}
+
+// name returns what the game is called in its share block
+func (w *Wordle) name() string {
+	switch {
+	case w.mode == Adversarial:
+		return "Absurdle"
+	case w.lies > 0:
+		return "Fibble"
+	default:
+		return "Wordle"
+	}
+}
+
+// writeHeader writes the first line of a share block, with the settings read
+// from w and the result given by guesses and won
+func writeHeader(b *strings.Builder, name string, w *Wordle, guesses int, won bool) {
+	fmt.Fprintf(b, "%s ", name)
+	switch w.mode {
+	case Daily:
+		fmt.Fprintf(b, "%d ", w.puzzle)
+	case Practice:
+		if w.seeded {
+			fmt.Fprintf(b, "practice #%d ", w.seed)
+		} else {
+			b.WriteString("practice ")
+		}
+	}
+	if w.length != WordLength {
+		fmt.Fprintf(b, "(%d letters) ", w.length)
+	}
+	if w.lies > 1 {
+		fmt.Fprintf(b, "(%d lies) ", w.lies)
+	}
+	tries := "∞"
+	if w.tries != Unlimited {
+		tries = fmt.Sprint(w.tries)
+	}
+	if won {
+		fmt.Fprintf(b, "%d/%s", guesses, tries)
+	} else {
+		fmt.Fprintf(b, "X/%s", tries)
+	}
+	if w.difficulty != Normal {
+		b.WriteString("*")
+	}
+	switch {
+	case w.hints == 1:
+		b.WriteString(" (1 hint)")
+	case w.hints > 1:
+		fmt.Fprintf(b, " (%d hints)", w.hints)
+	}
+	b.WriteString("\n")
+}
+
+// keycaps writes n with keycap emoji, like 4️⃣ or 1️⃣2️⃣
+func keycaps(n int) string {
+	var b strings.Builder
+	for _, digit := range fmt.Sprint(n) {
+		b.WriteRune(digit)
+		b.WriteString("\ufe0f\u20e3")
+	}
+	return b.String()
+}
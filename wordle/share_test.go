@@ -0,0 +1,95 @@
+package wordle
+
+import "testing"
+
+func TestShare(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		target  string
+		hints   int
+		guesses []string
+		palette Palette
+		want    string
+	}{
+		{
+			name:    "won daily",
+			options: []Option{WithPuzzle(100)},
+			target:  "ledge",
+			guesses: []string{"crane", "plate", "ledge"},
+			want:    "Wordle 100 3/6\n\n⬛⬛⬛⬛🟩\n⬛🟨⬛⬛🟩\n🟩🟩🟩🟩🟩",
+		},
+		{
+			name:    "lost hard practice",
+			options: []Option{WithSeed(1), WithDifficulty(Hard), WithTries(2)},
+			guesses: []string{"crane", "plate"},
+			want:    "Wordle practice #1 X/2*\n\n⬛⬛⬛⬛🟩\n⬛🟨⬛⬛🟩",
+		},
+		{
+			name:    "lost high contrast",
+			options: []Option{WithSeed(1), WithTries(2)},
+			guesses: []string{"crane", "plate"},
+			palette: HighContrast,
+			want:    "Wordle practice #1 X/2\n\n⬛⬛⬛⬛🟧\n⬛🟦⬛⬛🟧",
+		},
+		{
+			name:    "won with hints",
+			options: []Option{WithSeed(1)},
+			hints:   2,
+			guesses: []string{"crane", "ledge"},
+			want:    "Wordle practice #1 2/6 (2 hints)\n\n⬛⬛⬛⬛🟩\n🟩🟩🟩🟩🟩",
+		},
+		{
+			name:    "won hard with a hint",
+			options: []Option{WithPuzzle(7), WithLength(4), WithDifficulty(UltraHard), WithTries(Unlimited)},
+			target:  "nose",
+			hints:   1,
+			guesses: []string{"rose", "nose"},
+			want:    "Wordle 7 (4 letters) 2/∞* (1 hint)\n\n⬛🟩🟩🟩\n🟩🟩🟩🟩",
+		},
+	}
+	for _, tt := range tests {
+		w := New(tt.options...)
+		if tt.target != "" {
+			w.target = tt.target
+		}
+		for i := 0; i < tt.hints; i++ {
+			w.UseHint()
+		}
+		for _, guess := range tt.guesses {
+			if _, err := w.Guess(guess); err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+		}
+		if got := w.Share(tt.palette); got != tt.want {
+			t.Errorf("%s: Share() =\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestShareBoards(t *testing.T) {
+	// seed 42 picks moody, paper, humus and croup
+	g := NewGame(4, WithSeed(42))
+	for _, guess := range []string{"paper", "moody", "crane", "plate", "ledge", "mound", "blimp", "fight", "stock"} {
+		if _, err := g.Guess(guess); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := "Quordle practice #42 X/9\n\n2️⃣1️⃣\n🟥🟥"
+	if got := g.Share(Classic); got != want {
+		t.Errorf("Share() =\n%s\nwant\n%s", got, want)
+	}
+
+	g = NewGame(2, WithPuzzle(5))
+	g.boards[0].target, g.boards[1].target = "ledge", "plate"
+	g.UseHint()
+	for _, guess := range []string{"crane", "plate", "hedge", "ledge"} {
+		if _, err := g.Guess(guess); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want = "Dordle 5 4/7 (1 hint)\n\n4️⃣2️⃣"
+	if got := g.Share(Classic); got != want {
+		t.Errorf("Share() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,136 @@
+package wordle
+
+import "fmt"
+
+// Snapshot holds everything needed to rebuild a game in progress, in a form
+// that can be stored as JSON
+type Snapshot struct {
+	Length     int        `json:"length"`
+	Tries      int        `json:"tries"`
+	Mode       Mode       `json:"mode"`
+	Puzzle     int        `json:"puzzle"`
+	Seed       int64      `json:"seed"`
+	Difficulty Difficulty `json:"difficulty"`
+	Target     string     `json:"target"`
+	Guesses    []string   `json:"guesses"`
+	Hints      int        `json:"hints,omitempty"`
+	Lies       int        `json:"lies,omitempty"`
+	// Targets holds the target of every board of a game with more than one
+	Targets []string `json:"targets,omitempty"`
+}
+
+// Snapshot captures the current state of the game
+func (w *Wordle) Snapshot() Snapshot {
+	s := Snapshot{
+		Length:     w.length,
+		Tries:      w.tries,
+		Mode:       w.mode,
+		Puzzle:     w.puzzle,
+		Seed:       w.seed,
+		Difficulty: w.difficulty,
+		Target:     w.target,
+		Guesses:    make([]string, len(w.rows)),
+		Hints:      w.hints,
+		Lies:       w.lies,
+	}
+	for i, row := range w.rows {
+		s.Guesses[i] = row.Word
+	}
+	return s
+}
+
+// Restore rebuilds a game from a snapshot by replaying its guesses
+func Restore(s Snapshot) (*Wordle, error) {
+	// games saved before other lengths and tries were supported don't have them
+	if s.Length == 0 {
+		s.Length = WordLength
+	}
+	if s.Tries == 0 {
+		s.Tries = MaxGuesses
+	}
+	if !ValidLength(s.Length) {
+		return nil, fmt.Errorf("invalid length %d", s.Length)
+	}
+	if s.Tries < 1 && s.Tries != Unlimited {
+		return nil, fmt.Errorf("invalid tries %d", s.Tries)
+	}
+	if s.Lies < 0 || s.Lies > s.Length {
+		return nil, fmt.Errorf("invalid lies %d", s.Lies)
+	}
+	// adversarial games work their target out again from the guesses
+	if s.Mode != Adversarial && len(s.Target) != s.Length {
+		return nil, fmt.Errorf("invalid target %q", s.Target)
+	}
+
+	opts := []Option{WithLength(s.Length), WithTries(s.Tries), WithDifficulty(s.Difficulty), WithSeed(s.Seed), WithLies(s.Lies)}
+	switch s.Mode {
+	case Daily:
+		opts = append(opts, WithPuzzle(s.Puzzle))
+	case Adversarial:
+		opts = append(opts, WithAdversary())
+	}
+	w := New(opts...)
+	if s.Mode != Adversarial {
+		w.target = s.Target
+	}
+	w.hints = s.Hints
+
+	for _, guess := range s.Guesses {
+		if _, err := w.Guess(guess); err != nil {
+			return nil, fmt.Errorf("replaying %q: %w", guess, err)
+		}
+	}
+	return w, nil
+}
+
+// Snapshot captures the current state of the game
+func (g *Game) Snapshot() Snapshot {
+	if len(g.boards) == 1 {
+		return g.boards[0].Snapshot()
+	}
+
+	// solved boards stop taking guesses, so the board played the longest has
+	// all of them
+	longest := g.boards[0]
+	for _, b := range g.boards {
+		if len(b.rows) > len(longest.rows) {
+			longest = b
+		}
+	}
+	s := longest.Snapshot()
+	s.Targets = make([]string, len(g.boards))
+	for i, b := range g.boards {
+		s.Targets[i] = b.target
+	}
+	s.Target = s.Targets[0]
+	return s
+}
+
+// RestoreGame rebuilds a game from a snapshot by replaying its guesses.
+// Snapshots of a single Wordle give a game with one board.
+func RestoreGame(s Snapshot) (*Game, error) {
+	targets := s.Targets
+	if len(targets) == 0 {
+		targets = []string{s.Target}
+	}
+	guesses := s.Guesses
+	s.Guesses = nil
+	s.Targets = nil
+
+	g := &Game{boards: make([]*Wordle, len(targets)), state: Playing}
+	for i, target := range targets {
+		s.Target = target
+		b, err := Restore(s)
+		if err != nil {
+			return nil, err
+		}
+		b.seedLiar(i)
+		g.boards[i] = b
+	}
+	for _, guess := range guesses {
+		if _, err := g.Guess(guess); err != nil {
+			return nil, fmt.Errorf("replaying %q: %w", guess, err)
+		}
+	}
+	return g, nil
+}
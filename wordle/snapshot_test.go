@@ -0,0 +1,70 @@
+package wordle
+
+import "testing"
+
+func TestRestore(t *testing.T) {
+	w := New(WithSeed(42), WithTries(8))
+	for _, guess := range []string{"crane", "moist"} {
+		if _, err := w.Guess(guess); err != nil {
+			t.Fatal(err)
+		}
+	}
+	restored, err := Restore(w.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Target() != w.Target() || restored.Tries() != 8 || len(restored.Rows()) != 2 {
+		t.Errorf("restored %q with %d tries and %d rows, want %q with 8 tries and 2 rows",
+			restored.Target(), restored.Tries(), len(restored.Rows()), w.Target())
+	}
+}
+
+func TestRestoreTries(t *testing.T) {
+	tests := []struct {
+		tries int
+		want  int
+		ok    bool
+	}{
+		{6, 6, true},
+		{1, 1, true},
+		{Unlimited, Unlimited, true},
+		// saved before the number of tries could be changed
+		{0, MaxGuesses, true},
+		{-7, 0, false},
+		{-2, 0, false},
+	}
+	for _, tt := range tests {
+		s := New(WithSeed(1)).Snapshot()
+		s.Tries = tt.tries
+		w, err := Restore(s)
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("Restore with %d tries should fail", tt.tries)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Restore with %d tries: %v", tt.tries, err)
+		} else if w.Tries() != tt.want {
+			t.Errorf("Restore with %d tries gave %d tries, want %d", tt.tries, w.Tries(), tt.want)
+		}
+	}
+}
+
+func TestRestoreLies(t *testing.T) {
+	for _, lies := range []int{-1, 6, 7} {
+		s := New(WithSeed(1)).Snapshot()
+		s.Lies = lies
+		if _, err := Restore(s); err == nil {
+			t.Errorf("Restore with %d lies should fail", lies)
+		}
+		if _, err := RestoreGame(s); err == nil {
+			t.Errorf("RestoreGame with %d lies should fail", lies)
+		}
+	}
+
+	s := New(WithSeed(1), WithLies(5)).Snapshot()
+	if w, err := Restore(s); err != nil || w.Lies() != 5 {
+		t.Errorf("Restore with 5 lies = %v, want a game with 5 lies", err)
+	}
+}
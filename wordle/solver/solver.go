@@ -0,0 +1,360 @@
+// Package solver suggests Wordle guesses. It keeps track of which answers are
+// still possible given the feedback seen so far and ranks every legal guess
+// by how well it is expected to narrow them down.
+package solver
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// Strategy is how guesses are ranked
+type Strategy int
+
+const (
+	// Entropy prefers the guess whose feedback carries the most information
+	// on average, measured in bits
+	Entropy Strategy = iota
+	// Minimax prefers the guess whose worst feedback leaves the fewest answers
+	Minimax
+	// ExpectedRemaining prefers the guess that leaves the fewest answers on
+	// average
+	ExpectedRemaining
+)
+
+var strategyNames = map[Strategy]string{
+	Entropy:           "entropy",
+	Minimax:           "minimax",
+	ExpectedRemaining: "expected",
+}
+
+func (s Strategy) String() string {
+	return strategyNames[s]
+}
+
+// ParseStrategy returns the strategy with the given name, as printed by String
+func ParseStrategy(name string) (Strategy, error) {
+	for s, n := range strategyNames {
+		if n == name {
+			return s, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown strategy %q, expected entropy, minimax or expected", name)
+}
+
+// Suggestion is a ranked guess. Higher scores are better for every strategy;
+// Minimax and ExpectedRemaining scores are negated so that holds.
+type Suggestion struct {
+	Word  string
+	Score float64
+	// Candidate is set when the word could itself be the answer
+	Candidate bool
+}
+
+type Solver struct {
+	length     int
+	strategy   Strategy
+	guesses    []string
+	candidates []string
+	rows       []wordle.Row
+	lies       int
+	difficulty wordle.Difficulty
+	// set when the standard word lists are used, so the cached opening applies
+	defaultWords bool
+}
+
+// Option configures a solver created with New
+type Option func(*Solver)
+
+func WithStrategy(strategy Strategy) Option {
+	return func(s *Solver) {
+		s.strategy = strategy
+	}
+}
+
+// WithLength makes the solver use the word lists for words of the given
+// length instead of five letter words
+func WithLength(length int) Option {
+	return func(s *Solver) {
+		s.length = length
+	}
+}
+
+// WithLies makes the solver expect exactly n tiles of every feedback to be
+// lies, as in games created with wordle.WithLies
+func WithLies(n int) Option {
+	return func(s *Solver) {
+		s.lies = n
+	}
+}
+
+// WithDifficulty makes the solver only suggest guesses that respect the hints
+// revealed so far, as games created with wordle.WithDifficulty require
+func WithDifficulty(d wordle.Difficulty) Option {
+	return func(s *Solver) {
+		s.difficulty = d
+	}
+}
+
+// WithWords replaces the default word lists: candidates are the possible
+// answers and guesses the words that may be guessed
+func WithWords(candidates []string, guesses []string) Option {
+	return func(s *Solver) {
+		s.candidates = candidates
+		s.guesses = guesses
+	}
+}
+
+// New returns a solver for a fresh game over the standard word lists
+func New(opts ...Option) *Solver {
+	s := &Solver{length: wordle.WordLength}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.candidates == nil && s.guesses == nil {
+		s.defaultWords = true
+	}
+	if s.candidates == nil {
+		s.candidates = wordle.Answers(s.length)
+	}
+	if s.guesses == nil {
+		s.guesses = legalGuesses(s.length)
+	}
+	return s
+}
+
+var legal = struct {
+	sync.Mutex
+	guesses map[int][]string
+}{guesses: make(map[int][]string)}
+
+// the legal guess list of each length is sorted once and shared by every
+// solver
+func legalGuesses(length int) []string {
+	legal.Lock()
+	defer legal.Unlock()
+	if _, ok := legal.guesses[length]; !ok {
+		legal.guesses[length] = wordle.LegalGuesses(length)
+	}
+	return legal.guesses[length]
+}
+
+// Update narrows down the candidates with the feedback a guess received. In
+// hard modes the guesses that no longer respect the hints are dropped too.
+func (s *Solver) Update(guess string, feedback wordle.Feedback) {
+	row := wordle.Row{Word: guess, Feedback: feedback}
+	s.rows = append(s.rows, row)
+	if s.lies > 0 {
+		s.candidates = FilterLies(s.candidates, guess, feedback, s.lies)
+	} else {
+		s.candidates = Filter(s.candidates, guess, feedback)
+	}
+	if s.difficulty != wordle.Normal {
+		// every row is checked when a guess is made, but the guesses left
+		// already respect the earlier ones
+		allowed := make([]string, 0, len(s.guesses))
+		for _, word := range s.guesses {
+			if wordle.CheckHints(word, []wordle.Row{row}, s.difficulty) == nil {
+				allowed = append(allowed, word)
+			}
+		}
+		s.guesses = allowed
+	}
+}
+
+// Candidates returns the answers that are still possible
+func (s *Solver) Candidates() []string {
+	return s.candidates
+}
+
+// Filter returns the words of candidates that would have given feedback for
+// guess if they were the answer
+func Filter(candidates []string, guess string, feedback wordle.Feedback) []string {
+	code := feedback.Code()
+	remaining := make([]string, 0, len(candidates))
+	for _, word := range candidates {
+		if wordle.ScoreCode(guess, word) == code {
+			remaining = append(remaining, word)
+		}
+	}
+	return remaining
+}
+
+// FilterLies returns the words of candidates that would have given feedback
+// for guess if they were the answer and exactly lies of its tiles were wrong.
+// The answer itself is never lied about, so guess is left out unless the
+// feedback says it was solved.
+func FilterLies(candidates []string, guess string, feedback wordle.Feedback, lies int) []string {
+	remaining := make([]string, 0, len(candidates))
+	for _, word := range candidates {
+		if word == guess {
+			if feedback.Solved() {
+				remaining = append(remaining, word)
+			}
+			continue
+		}
+		wrong := 0
+		for i, t := range wordle.Score(guess, word) {
+			if t != feedback[i] {
+				wrong++
+			}
+		}
+		if wrong == lies {
+			remaining = append(remaining, word)
+		}
+	}
+	return remaining
+}
+
+// Best returns the suggested next guess, or "" if no answer is possible
+func (s *Solver) Best() string {
+	switch len(s.candidates) {
+	case 0:
+		return ""
+	case 1, 2:
+		// guessing a candidate can't do worse than any other guess
+		return s.candidates[0]
+	}
+	if len(s.rows) == 0 {
+		return s.opening()
+	}
+	return s.Rank(1)[0].Word
+}
+
+type opening struct {
+	strategy Strategy
+	length   int
+}
+
+// openings caches the first guess of each strategy over the default word
+// lists, since it's the same for every game and the most expensive to rank
+var openings = struct {
+	sync.Mutex
+	words map[opening]string
+}{words: make(map[opening]string)}
+
+// Opening returns the best first guess for strategy over the default word
+// lists for words of the given length. It is worked out on the first call
+// and remembered afterwards, so calling it in the background when a game
+// starts saves the wait later.
+func Opening(strategy Strategy, length int) string {
+	openings.Lock()
+	defer openings.Unlock()
+	key := opening{strategy, length}
+	if word, ok := openings.words[key]; ok {
+		return word
+	}
+	word := New(WithStrategy(strategy), WithLength(length)).Rank(1)[0].Word
+	openings.words[key] = word
+	return word
+}
+
+func (s *Solver) opening() string {
+	if s.defaultWords {
+		return Opening(s.strategy, s.length)
+	}
+	return s.Rank(1)[0].Word
+}
+
+// Rank scores every legal guess against the remaining candidates and returns
+// the best n, best first. Ties go to words that could be the answer, then to
+// alphabetical order.
+func (s *Solver) Rank(n int) []Suggestion {
+	isCandidate := make(map[string]bool, len(s.candidates))
+	for _, word := range s.candidates {
+		isCandidate[word] = true
+	}
+
+	suggestions := make([]Suggestion, len(s.guesses))
+	workers := runtime.GOMAXPROCS(0)
+	chunk := (len(s.guesses) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < len(s.guesses); start += chunk {
+		end := start + chunk
+		if end > len(s.guesses) {
+			end = len(s.guesses)
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			buckets := make([]int, patterns(s.guesses[start]))
+			for i := start; i < end; i++ {
+				word := s.guesses[i]
+				suggestions[i] = Suggestion{
+					Word:      word,
+					Score:     s.score(word, buckets),
+					Candidate: isCandidate[word],
+				}
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Candidate != b.Candidate {
+			return a.Candidate
+		}
+		return a.Word < b.Word
+	})
+	if n < len(suggestions) {
+		suggestions = suggestions[:n]
+	}
+	return suggestions
+}
+
+// number of different feedbacks a word of the same length as word can get
+func patterns(word string) int {
+	n := 1
+	for range word {
+		n *= 3
+	}
+	return n
+}
+
+// score groups the candidates by the feedback guess would get and rates the
+// split according to the strategy. buckets is scratch space with room for
+// every feedback code.
+func (s *Solver) score(guess string, buckets []int) float64 {
+	for i := range buckets {
+		buckets[i] = 0
+	}
+	for _, word := range s.candidates {
+		buckets[wordle.ScoreCode(guess, word)]++
+	}
+
+	total := float64(len(s.candidates))
+	switch s.strategy {
+	case Minimax:
+		largest := 0
+		for _, count := range buckets {
+			if count > largest {
+				largest = count
+			}
+		}
+		return -float64(largest)
+	case ExpectedRemaining:
+		sum := 0.0
+		for _, count := range buckets {
+			sum += float64(count * count)
+		}
+		return -sum / total
+	default:
+		bits := 0.0
+		for _, count := range buckets {
+			if count > 0 {
+				p := float64(count) / total
+				bits -= p * math.Log2(p)
+			}
+		}
+		return bits
+	}
+}
@@ -0,0 +1,40 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// following the solver's suggestions in a hard mode game must never get a
+// guess rejected
+func TestBestFollowsHardMode(t *testing.T) {
+	for _, d := range []wordle.Difficulty{wordle.Hard, wordle.UltraHard} {
+		for puzzle := 0; puzzle < 25; puzzle++ {
+			w := wordle.New(wordle.WithPuzzle(puzzle), wordle.WithDifficulty(d))
+			s := New(WithDifficulty(d))
+			for !w.Over() {
+				best := s.Best()
+				feedback, err := w.Guess(best)
+				if err != nil {
+					t.Fatalf("%s puzzle %d: suggested %q after %v: %v", d, puzzle, best, w.Rows(), err)
+				}
+				s.Update(best, feedback)
+			}
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	candidates := []string{"abide", "speed", "crane", "aside", "amide"}
+	got := Filter(candidates, "speed", wordle.Score("speed", "abide"))
+	want := []string{"abide", "amide"}
+	if len(got) != len(want) {
+		t.Fatalf("Filter = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Filter = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package store
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// SavePath returns where a game in progress is saved by default
+func SavePath() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "game.json"), nil
+}
+
+// SaveGame saves the game in progress w at path
+func SaveGame(path string, w *wordle.Game) error {
+	return writeJSON(path, w.Snapshot())
+}
+
+// LoadGame restores the game saved at path. It returns nil if there is no
+// saved game.
+func LoadGame(path string) (*wordle.Game, error) {
+	var snapshot *wordle.Snapshot
+	if err := readJSON(path, &snapshot); err != nil || snapshot == nil {
+		return nil, err
+	}
+	return wordle.RestoreGame(*snapshot)
+}
+
+// ClearGame removes the game saved at path, if any
+func ClearGame(path string) error {
+	err := os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,180 @@
+package store
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/x2dtu/wordle/wordle"
+)
+
+// Record is the result of one completed game
+type Record struct {
+	Date       time.Time `json:"date"`
+	Mode       string    `json:"mode"`
+	Length     int       `json:"length,omitempty"`
+	Boards     int       `json:"boards,omitempty"`
+	Tries      int       `json:"tries,omitempty"`
+	Puzzle     int       `json:"puzzle,omitempty"`
+	Seed       int64     `json:"seed,omitempty"`
+	Difficulty string    `json:"difficulty"`
+	Won        bool      `json:"won"`
+	Guesses    int       `json:"guesses"`
+	Hints      int       `json:"hints,omitempty"`
+	Lies       int       `json:"lies,omitempty"`
+	// Words are the guesses, so a finished daily puzzle can be shown again.
+	// Games recorded before they were kept don't have them.
+	Words []string `json:"words,omitempty"`
+}
+
+// NewRecord describes the finished game w, played on date
+func NewRecord(w *wordle.Game, date time.Time) Record {
+	r := Record{
+		Date:       date,
+		Mode:       w.Mode().String(),
+		Length:     w.Length(),
+		Boards:     len(w.Boards()),
+		Tries:      w.Tries(),
+		Difficulty: w.Difficulty().String(),
+		Won:        w.State() == wordle.Won,
+		Guesses:    w.Guesses(),
+		Hints:      w.Hints(),
+		Lies:       w.Lies(),
+		Words:      w.Snapshot().Guesses,
+	}
+	switch w.Mode() {
+	case wordle.Daily:
+		r.Puzzle = w.Puzzle()
+	case wordle.Practice:
+		r.Seed = w.Seed()
+	}
+	return r
+}
+
+// Variant is a kind of game that statistics are kept for separately
+type Variant struct {
+	Mode   string // as given by Mode.String
+	Length int
+	Boards int
+	Lies   int
+}
+
+// VariantOf returns the variant played in w
+func VariantOf(w *wordle.Game) Variant {
+	return Variant{
+		Mode:   w.Mode().String(),
+		Length: w.Length(),
+		Boards: len(w.Boards()),
+		Lies:   w.Lies(),
+	}
+}
+
+// variant returns the variant r was played in. Games recorded before other
+// lengths and boards were supported don't have them.
+func (r Record) variant() Variant {
+	v := Variant{Mode: r.Mode, Length: r.Length, Boards: r.Boards, Lies: r.Lies}
+	if v.Length == 0 {
+		v.Length = wordle.WordLength
+	}
+	if v.Boards == 0 {
+		v.Boards = 1
+	}
+	return v
+}
+
+// Summary is what the statistics screen shows for one variant
+type Summary struct {
+	Played int
+	// Won only counts clean wins; games won with hints are counted in Hinted
+	// and neither extend nor break a streak
+	Won           int
+	Hinted        int
+	CurrentStreak int
+	MaxStreak     int
+	// Distribution counts the wins by number of guesses, so Distribution[0]
+	// is the number of games won on the first guess. It has room for at least
+	// MaxGuesses and grows when games with more tries were won in more.
+	Distribution []int
+}
+
+// WinPercent returns the percentage of games won, rounded down
+func (s Summary) WinPercent() int {
+	if s.Played == 0 {
+		return 0
+	}
+	return s.Won * 100 / s.Played
+}
+
+// Stats is the history of completed games, kept in a JSON file
+type Stats struct {
+	path  string
+	Games []Record `json:"games"`
+}
+
+// StatsPath returns where statistics are kept by default
+func StatsPath() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "stats.json"), nil
+}
+
+// OpenStats loads the statistics kept at path. A missing file gives empty
+// statistics that will be created on the first Add.
+func OpenStats(path string) (*Stats, error) {
+	s := &Stats{path: path}
+	if err := readJSON(path, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Add records a completed game and saves the statistics
+func (s *Stats) Add(r Record) error {
+	s.Games = append(s.Games, r)
+	return writeJSON(s.path, s)
+}
+
+// Daily returns the record of the given daily puzzle played in variant v, if
+// it was played
+func (s *Stats) Daily(puzzle int, v Variant) (Record, bool) {
+	for _, r := range s.Games {
+		if r.Mode == wordle.Daily.String() && r.Puzzle == puzzle && r.variant() == v {
+			return r, true
+		}
+	}
+	return Record{}, false
+}
+
+// Summary adds up the games played in the given variant
+func (s *Stats) Summary(v Variant) Summary {
+	sum := Summary{Distribution: make([]int, wordle.MaxGuesses)}
+	streak := 0
+	for _, r := range s.Games {
+		if r.variant() != v {
+			continue
+		}
+		sum.Played++
+		if !r.Won {
+			streak = 0
+			continue
+		}
+		if r.Hints > 0 {
+			sum.Hinted++
+			continue
+		}
+		sum.Won++
+		streak++
+		if streak > sum.MaxStreak {
+			sum.MaxStreak = streak
+		}
+		for len(sum.Distribution) < r.Guesses {
+			sum.Distribution = append(sum.Distribution, 0)
+		}
+		if r.Guesses >= 1 {
+			sum.Distribution[r.Guesses-1]++
+		}
+	}
+	sum.CurrentStreak = streak
+	return sum
+}
@@ -0,0 +1,54 @@
+// Package store keeps the files the game writes between runs, such as
+// statistics, under the user's XDG data directory.
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// DataDir returns the directory game files are kept in, which is
+// $XDG_DATA_HOME/wordle or ~/.local/share/wordle when that isn't set
+func DataDir() (string, error) {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "wordle"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "share", "wordle"), nil
+}
+
+// readJSON decodes the file at path into v. A missing file leaves v untouched
+// and isn't an error.
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeJSON replaces the file at path with v encoded as JSON. The file is
+// written next to its destination first and renamed over it, so a crash
+// never leaves a half written file behind.
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
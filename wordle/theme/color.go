@@ -0,0 +1,264 @@
+package theme
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Depth is how many colors a terminal can show
+type Depth int
+
+const (
+	// NoColor only styles text with bold, underline and reverse
+	NoColor Depth = iota
+	// Basic is the 8 standard colors, each with a bright version
+	Basic
+	// Palette is the 256 color palette
+	Palette
+	// TrueColor is any 24-bit color
+	TrueColor
+)
+
+var depthNames = map[Depth]string{
+	NoColor:   "none",
+	Basic:     "16",
+	Palette:   "256",
+	TrueColor: "truecolor",
+}
+
+func (d Depth) String() string {
+	return depthNames[d]
+}
+
+// ParseDepth returns the depth with the given name, as printed by String
+func ParseDepth(name string) (Depth, error) {
+	for d, n := range depthNames {
+		if n == name {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown color depth %q, expected none, 16, 256 or truecolor", name)
+}
+
+// Detect works out the depth of the terminal from the environment: NO_COLOR
+// turns colors off, COLORTERM announces 24-bit colors and a TERM ending in
+// 256color the 256 color palette
+func Detect(getenv func(string) string) Depth {
+	if getenv("NO_COLOR") != "" {
+		return NoColor
+	}
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return TrueColor
+	}
+	if strings.HasSuffix(getenv("TERM"), "256color") {
+		return Palette
+	}
+	return Basic
+}
+
+type colorKind int
+
+const (
+	defaultColor colorKind = iota
+	basicColor             // value is 0-7, or 8-15 for the bright versions
+	paletteColor           // value is an index into the 256 color palette
+	rgbColor               // value is 0xRRGGBB
+)
+
+// Color is a text color, given as one of the 16 basic colors, an index into
+// the 256 color palette or a 24-bit RGB value. The zero Color is the
+// terminal's default. Colors are matched to the closest one a terminal can
+// show when it has fewer, unless a basic color to use instead is given with
+// Or.
+type Color struct {
+	kind  colorKind
+	value uint32
+	// basic color used on terminals with only 16 colors, plus one so that 0
+	// means there is none
+	fallback uint8
+}
+
+var basicNames = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// BasicColor returns one of the 8 standard colors, numbered like their ANSI
+// codes, or its bright version
+func BasicColor(n int, bright bool) Color {
+	if bright {
+		n += 8
+	}
+	return Color{kind: basicColor, value: uint32(n)}
+}
+
+// PaletteColor returns the color at index n of the 256 color palette
+func PaletteColor(n uint8) Color {
+	return Color{kind: paletteColor, value: uint32(n)}
+}
+
+// RGB returns a 24-bit color
+func RGB(r, g, b uint8) Color {
+	return Color{kind: rgbColor, value: uint32(r)<<16 | uint32(g)<<8 | uint32(b)}
+}
+
+// Or returns the color with basic used in its place on terminals with only
+// 16 colors, where the closest one might not be the best choice
+func (c Color) Or(basic Color) Color {
+	if basic.kind == basicColor {
+		c.fallback = uint8(basic.value) + 1
+	}
+	return c
+}
+
+// ParseColor reads a color written as a basic color's name like "green" or
+// "bright-black" ("gray" for short), a palette index from 0 to 255 or an RGB
+// value like "#6aaa64". "default" is the terminal's default color. A color
+// can be followed by /name to give the basic color used instead of it on
+// terminals with only 16 colors, as in "#f5793a/bright-red".
+func ParseColor(s string) (Color, error) {
+	s = strings.ToLower(s)
+	if i := strings.LastIndex(s, "/"); i >= 0 {
+		color, err := ParseColor(s[:i])
+		if err != nil {
+			return Color{}, err
+		}
+		basic, err := ParseColor(s[i+1:])
+		if err != nil || basic.kind != basicColor {
+			return Color{}, fmt.Errorf("invalid color %q, expected a basic color like red after /", s)
+		}
+		return color.Or(basic), nil
+	}
+	switch s {
+	case "default":
+		return Color{}, nil
+	case "gray", "grey":
+		return BasicColor(0, true), nil
+	}
+	name := strings.TrimPrefix(s, "bright-")
+	for i, n := range basicNames {
+		if n == name {
+			return BasicColor(i, name != s), nil
+		}
+	}
+	if strings.HasPrefix(s, "#") && len(s) == 7 {
+		if v, err := strconv.ParseUint(s[1:], 16, 32); err == nil {
+			return Color{kind: rgbColor, value: uint32(v)}, nil
+		}
+	}
+	if n, err := strconv.ParseUint(s, 10, 8); err == nil {
+		return PaletteColor(uint8(n)), nil
+	}
+	return Color{}, fmt.Errorf("invalid color %q, expected a name like green, a number from 0 to 255 or #rrggbb", s)
+}
+
+// the basic colors as xterm shows them
+var basicRGB = [16]uint32{
+	0x000000, 0xcd0000, 0x00cd00, 0xcdcd00, 0x0000ee, 0xcd00cd, 0x00cdcd, 0xe5e5e5,
+	0x7f7f7f, 0xff0000, 0x00ff00, 0xffff00, 0x5c5cff, 0xff00ff, 0x00ffff, 0xffffff,
+}
+
+// levels of each channel in the 6x6x6 color cube of the 256 color palette
+var cubeLevels = [6]uint32{0, 95, 135, 175, 215, 255}
+
+// rgb returns the color as a 24-bit value
+func (c Color) rgb() uint32 {
+	switch c.kind {
+	case basicColor:
+		return basicRGB[c.value]
+	case paletteColor:
+		switch n := c.value; {
+		case n < 16:
+			return basicRGB[n]
+		case n < 232:
+			n -= 16
+			return cubeLevels[n/36]<<16 | cubeLevels[n/6%6]<<8 | cubeLevels[n%6]
+		default:
+			gray := 8 + (n-232)*10
+			return gray<<16 | gray<<8 | gray
+		}
+	}
+	return c.value
+}
+
+// squared distance between two 24-bit colors
+func distance(a, b uint32) int {
+	d := 0
+	for shift := 0; shift <= 16; shift += 8 {
+		x := int(a>>shift&0xff) - int(b>>shift&0xff)
+		d += x * x
+	}
+	return d
+}
+
+// toBasic returns the basic color given with Or, or else the one with the
+// same hue: each channel is either on or off, and colors that are bright
+// enough get the bright version. Going by distance instead would turn most
+// muted colors gray.
+func (c Color) toBasic() Color {
+	if c.kind == basicColor {
+		return c
+	}
+	if c.fallback > 0 {
+		return Color{kind: basicColor, value: uint32(c.fallback - 1)}
+	}
+	rgb := c.rgb()
+	r, g, b := rgb>>16, rgb>>8&0xff, rgb&0xff
+	brightest := r
+	if g > brightest {
+		brightest = g
+	}
+	if b > brightest {
+		brightest = b
+	}
+	n := 0
+	for i, channel := range []uint32{r, g, b} {
+		if channel >= 128 {
+			n |= 1 << i
+		}
+	}
+	switch {
+	case brightest < 64:
+		n = 0
+	case brightest >= 192:
+		n += 8
+	}
+	return BasicColor(n%8, n >= 8)
+}
+
+// toPalette returns the closest color of the palette's color cube and gray
+// ramp. Basic colors are left alone, since the palette starts with them.
+func (c Color) toPalette() Color {
+	if c.kind != rgbColor {
+		return c
+	}
+	best := uint32(16)
+	for n := uint32(16); n < 256; n++ {
+		if distance(c.value, PaletteColor(uint8(n)).rgb()) < distance(c.value, PaletteColor(uint8(best)).rgb()) {
+			best = n
+		}
+	}
+	return PaletteColor(uint8(best))
+}
+
+// sgr returns the parameters of the SGR escape sequence that sets the color
+// as a foreground color, matched to what a terminal of depth d can show.
+// Bright basic colors are drawn as bold, which every terminal supports. The
+// board is drawn with at most 256 colors, so terminals with 24-bit colors get
+// the closest palette color too.
+func (c Color) sgr(d Depth) string {
+	if c.kind == defaultColor || d == NoColor {
+		return ""
+	}
+	if d == Basic {
+		c = c.toBasic()
+	} else {
+		c = c.toPalette()
+	}
+	if c.kind == basicColor {
+		if c.value >= 8 {
+			return fmt.Sprintf("3%d;1", c.value-8)
+		}
+		return fmt.Sprintf("3%d", c.value)
+	}
+	return fmt.Sprintf("38;5;%d", c.value)
+}
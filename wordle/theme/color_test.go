@@ -0,0 +1,115 @@
+package theme
+
+import "testing"
+
+func TestParseColor(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want Color
+	}{
+		{"default", Color{}},
+		{"green", BasicColor(2, false)},
+		{"Bright-Red", BasicColor(1, true)},
+		{"gray", BasicColor(0, true)},
+		{"grey", BasicColor(0, true)},
+		{"0", PaletteColor(0)},
+		{"208", PaletteColor(208)},
+		{"#6aaa64", RGB(0x6a, 0xaa, 0x64)},
+		{"#C9B458", RGB(0xc9, 0xb4, 0x58)},
+		{"#f5793a/bright-red", RGB(0xf5, 0x79, 0x3a).Or(BasicColor(1, true))},
+		{"75/blue", PaletteColor(75).Or(BasicColor(4, false))},
+	} {
+		got, err := ParseColor(test.in)
+		if err != nil || got != test.want {
+			t.Errorf("ParseColor(%q) = %v, %v, want %v", test.in, got, err, test.want)
+		}
+	}
+
+	for _, in := range []string{"", "purple", "bright-gray", "256", "-1", "#6aaa6", "#6aaa645", "#gggggg", "6aaa64", "#6aaa64/#000000", "#6aaa64/208", "red/"} {
+		if got, err := ParseColor(in); err == nil {
+			t.Errorf("ParseColor(%q) = %v, want an error", in, got)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	for _, test := range []struct {
+		env  map[string]string
+		want Depth
+	}{
+		{map[string]string{}, Basic},
+		{map[string]string{"TERM": "xterm"}, Basic},
+		{map[string]string{"TERM": "xterm-256color"}, Palette},
+		{map[string]string{"TERM": "screen-256color", "COLORTERM": "yes"}, Palette},
+		{map[string]string{"COLORTERM": "truecolor"}, TrueColor},
+		{map[string]string{"COLORTERM": "24bit", "TERM": "xterm"}, TrueColor},
+		{map[string]string{"COLORTERM": "TrueColor", "TERM": "xterm-256color"}, TrueColor},
+		{map[string]string{"NO_COLOR": "1", "TERM": "xterm-256color"}, NoColor},
+		{map[string]string{"NO_COLOR": "1", "COLORTERM": "truecolor"}, NoColor},
+	} {
+		getenv := func(key string) string { return test.env[key] }
+		if got := Detect(getenv); got != test.want {
+			t.Errorf("Detect(%v) = %s, want %s", test.env, got, test.want)
+		}
+	}
+}
+
+func TestToPalette(t *testing.T) {
+	for _, test := range []struct {
+		in   Color
+		want Color
+	}{
+		{RGB(0, 0, 0), PaletteColor(16)},
+		{RGB(0xff, 0, 0), PaletteColor(196)},
+		{RGB(0, 0xff, 0), PaletteColor(46)},
+		{RGB(0, 0, 0xff), PaletteColor(21)},
+		{RGB(0xff, 0xff, 0xff), PaletteColor(231)},
+		{RGB(0x87, 0xaf, 0xd7), PaletteColor(110)},
+		{RGB(0x6a, 0xaa, 0x64), PaletteColor(71)},
+		{RGB(0xc9, 0xb4, 0x58), PaletteColor(179)},
+		// grays between the levels of the cube are on the gray ramp
+		{RGB(0x80, 0x80, 0x80), PaletteColor(244)},
+		{RGB(0x12, 0x12, 0x12), PaletteColor(233)},
+		{RGB(0xee, 0xee, 0xee), PaletteColor(255)},
+		// colors already in the palette are left alone
+		{BasicColor(3, true), BasicColor(3, true)},
+		{PaletteColor(100), PaletteColor(100)},
+	} {
+		if got := test.in.toPalette(); got != test.want {
+			t.Errorf("%v.toPalette() = %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestToBasic(t *testing.T) {
+	for _, test := range []struct {
+		in   Color
+		want Color
+	}{
+		{RGB(0, 0, 0), BasicColor(0, false)},
+		{RGB(0x80, 0, 0), BasicColor(1, false)},
+		{RGB(0, 0x80, 0), BasicColor(2, false)},
+		{RGB(0x80, 0x80, 0), BasicColor(3, false)},
+		{RGB(0, 0, 0x80), BasicColor(4, false)},
+		{RGB(0x80, 0, 0x80), BasicColor(5, false)},
+		{RGB(0, 0x80, 0x80), BasicColor(6, false)},
+		{RGB(0x80, 0x80, 0x80), BasicColor(7, false)},
+		{RGB(0xff, 0, 0), BasicColor(1, true)},
+		{RGB(0, 0xff, 0), BasicColor(2, true)},
+		{RGB(0xff, 0xff, 0), BasicColor(3, true)},
+		{RGB(0, 0, 0xff), BasicColor(4, true)},
+		{RGB(0xff, 0xff, 0xff), BasicColor(7, true)},
+		// too dark to be anything but black
+		{RGB(0x3f, 0x3f, 0x3f), BasicColor(0, false)},
+		{RGB(0x6a, 0xaa, 0x64), BasicColor(2, false)},
+		{PaletteColor(196), BasicColor(1, true)},
+		{PaletteColor(9), BasicColor(1, true)},
+		{BasicColor(5, true), BasicColor(5, true)},
+		// the color given with Or wins over the closest one
+		{RGB(0xf5, 0x79, 0x3a).Or(BasicColor(1, true)), BasicColor(1, true)},
+	} {
+		if got := test.in.toBasic(); got != test.want {
+			t.Errorf("%v.toBasic() = %v, want %v", test.in, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Package theme describes how the game is colored. A theme gives a style to
+// every part of the game that is colored, such as the tiles of each state,
+// and styles are turned into escape sequences for what the terminal can
+// show. Besides the built-in themes, themes can be loaded from TOML or JSON
+// files like:
+//
+//	name = "dusk"
+//	correct = "#6aaa64 bold"
+//	present = "#c9b458"
+//	unused = "bright-white"
+//	# anything left out is taken from the classic theme
+//
+// Colors are written as a name like green or bright-black, a palette index
+// from 0 to 255 or #rrggbb, optionally followed by /name to pick the basic
+// color used on terminals with only 16 colors. They can be combined with
+// bold, underline and reverse.
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Style is a color together with the attributes text is drawn with
+type Style struct {
+	Color     Color
+	Bold      bool
+	Underline bool
+	Reverse   bool
+}
+
+// ParseStyle reads a style written as an optional color followed by any of
+// bold, underline and reverse, separated by spaces. The empty string is the
+// terminal's default style.
+func ParseStyle(s string) (Style, error) {
+	var style Style
+	for i, word := range strings.Fields(s) {
+		switch strings.ToLower(word) {
+		case "bold":
+			style.Bold = true
+		case "underline":
+			style.Underline = true
+		case "reverse":
+			style.Reverse = true
+		default:
+			if i > 0 {
+				return Style{}, fmt.Errorf("invalid style %q, expected a color followed by bold, underline or reverse", s)
+			}
+			color, err := ParseColor(word)
+			if err != nil {
+				return Style{}, err
+			}
+			style.Color = color
+		}
+	}
+	return style, nil
+}
+
+// UnmarshalText lets styles be written in theme files as strings, in the
+// form read by ParseStyle
+func (s *Style) UnmarshalText(text []byte) error {
+	style, err := ParseStyle(string(text))
+	if err != nil {
+		return err
+	}
+	*s = style
+	return nil
+}
+
+// Escape returns the escape sequence that starts drawing text in the style
+// on a terminal of depth d. Colors the terminal can't show are matched to the
+// closest one it can.
+func (s Style) Escape(d Depth) string {
+	var b strings.Builder
+	if sgr := s.Color.sgr(d); sgr != "" {
+		fmt.Fprintf(&b, "\x1b[%sm", sgr)
+	}
+	var attrs []string
+	if s.Bold {
+		attrs = append(attrs, "1")
+	}
+	if s.Underline {
+		attrs = append(attrs, "4")
+	}
+	if s.Reverse {
+		attrs = append(attrs, "7")
+	}
+	if len(attrs) > 0 {
+		fmt.Fprintf(&b, "\x1b[%sm", strings.Join(attrs, ";"))
+	}
+	return b.String()
+}
+
+// Theme is the style of every colored part of the game
+type Theme struct {
+	Name string `toml:"name" json:"name"`
+	// tiles of guesses and keys of the keyboard by what they revealed.
+	// Absent is only used for tiles, since absent keys are Muted.
+	Correct Style `toml:"correct" json:"correct"`
+	Present Style `toml:"present" json:"present"`
+	Absent  Style `toml:"absent" json:"absent"`
+	// keys of letters that haven't been guessed yet
+	Unused Style `toml:"unused" json:"unused"`
+	// absent keys and text that should stay in the background
+	Muted Style `toml:"muted" json:"muted"`
+	// words that can't be guessed, lost games and error messages
+	Error Style `toml:"error" json:"error"`
+	// headings, key shortcuts and other messages
+	Accent Style `toml:"accent" json:"accent"`
+	// won games
+	Success Style `toml:"success" json:"success"`
+	// Contrast makes share grids use orange and blue squares, to match
+	// themes that use those colors
+	Contrast bool `toml:"contrast" json:"contrast"`
+}
+
+var (
+	red     = BasicColor(1, false)
+	green   = BasicColor(2, false)
+	yellow  = BasicColor(3, false)
+	blue    = BasicColor(4, false)
+	cyan    = BasicColor(6, false)
+	white   = BasicColor(7, false)
+	gray    = BasicColor(0, true)
+	orange  = RGB(0xf5, 0x79, 0x3a).Or(BasicColor(1, true))
+	skyBlue = RGB(0x85, 0xc0, 0xf9).Or(BasicColor(4, true))
+)
+
+var builtins = []Theme{
+	{
+		Name:    "classic",
+		Correct: Style{Color: green},
+		Present: Style{Color: yellow},
+		Unused:  Style{Color: cyan},
+		Muted:   Style{Color: gray},
+		Error:   Style{Color: red},
+		Accent:  Style{Color: cyan},
+		Success: Style{Color: blue},
+	},
+	{
+		// orange and blue can be told apart with the common kinds of color
+		// blindness, like the NYT's high contrast mode
+		Name:     "colorblind",
+		Correct:  Style{Color: orange},
+		Present:  Style{Color: skyBlue},
+		Unused:   Style{Color: white},
+		Muted:    Style{Color: gray},
+		Error:    Style{Color: red},
+		Accent:   Style{Color: cyan},
+		Success:  Style{Color: skyBlue},
+		Contrast: true,
+	},
+	{
+		Name:    "high-contrast",
+		Correct: Style{Color: BasicColor(2, true), Bold: true},
+		Present: Style{Color: BasicColor(3, true), Bold: true},
+		Unused:  Style{Color: BasicColor(7, true), Bold: true},
+		Muted:   Style{Color: white},
+		Error:   Style{Color: BasicColor(1, true), Bold: true},
+		Accent:  Style{Color: BasicColor(6, true), Bold: true},
+		Success: Style{Color: BasicColor(4, true), Bold: true},
+	},
+	{
+		// for terminals without colors: correct tiles are reversed and
+		// present ones underlined
+		Name:    "monochrome",
+		Correct: Style{Reverse: true},
+		Present: Style{Underline: true},
+		Unused:  Style{Bold: true},
+		Error:   Style{Bold: true, Reverse: true},
+		Accent:  Style{Bold: true},
+		Success: Style{Bold: true},
+	},
+}
+
+// Names returns the names of the built-in themes
+func Names() []string {
+	names := make([]string, len(builtins))
+	for i, t := range builtins {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// Classic returns the default theme, with green and yellow tiles
+func Classic() *Theme {
+	t := builtins[0]
+	return &t
+}
+
+// Find returns the built-in theme with the given name, or loads the theme
+// file at that path if it ends in .toml or .json
+func Find(name string) (*Theme, error) {
+	for _, t := range builtins {
+		if t.Name == name {
+			return &t, nil
+		}
+	}
+	switch filepath.Ext(name) {
+	case ".toml", ".json":
+		return Load(name)
+	}
+	return nil, fmt.Errorf("unknown theme %q, expected %s or a .toml or .json file", name, strings.Join(Names(), ", "))
+}
+
+// Load reads a theme from a TOML or JSON file, depending on its extension.
+// Parts of the game the file doesn't give a style for keep the classic one,
+// and the theme is named after the file unless it has a name.
+func Load(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t := Classic()
+	t.Name = ""
+	switch filepath.Ext(path) {
+	case ".toml":
+		meta, err := toml.Decode(string(data), t)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("%s: unknown key %q", path, undecoded[0].String())
+		}
+	case ".json":
+		decoder := json.NewDecoder(strings.NewReader(string(data)))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(t); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("%s: theme files have to end in .toml or .json", path)
+	}
+	if t.Name == "" {
+		t.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return t, nil
+}
+
+// Escapes holds the escape sequence that starts each part of a theme
+type Escapes struct {
+	Correct, Present, Absent, Unused, Muted, Error, Accent, Success string
+}
+
+// Escapes works out the escape sequences of the theme for a terminal of
+// depth d
+func (t *Theme) Escapes(d Depth) Escapes {
+	return Escapes{
+		Correct: t.Correct.Escape(d),
+		Present: t.Present.Escape(d),
+		Absent:  t.Absent.Escape(d),
+		Unused:  t.Unused.Escape(d),
+		Muted:   t.Muted.Escape(d),
+		Error:   t.Error.Escape(d),
+		Accent:  t.Accent.Escape(d),
+		Success: t.Success.Escape(d),
+	}
+}
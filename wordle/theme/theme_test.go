@@ -0,0 +1,50 @@
+package theme
+
+import "testing"
+
+func TestParseStyle(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want Style
+	}{
+		{"", Style{}},
+		{"bold", Style{Bold: true}},
+		{"green", Style{Color: BasicColor(2, false)}},
+		{"green bold underline", Style{Color: BasicColor(2, false), Bold: true, Underline: true}},
+		{"#6aaa64 Reverse", Style{Color: RGB(0x6a, 0xaa, 0x64), Reverse: true}},
+		{"  208   BOLD ", Style{Color: PaletteColor(208), Bold: true}},
+	} {
+		got, err := ParseStyle(test.in)
+		if err != nil || got != test.want {
+			t.Errorf("ParseStyle(%q) = %v, %v, want %v", test.in, got, err, test.want)
+		}
+	}
+
+	for _, in := range []string{"purple", "bold green", "green blue", "green blink", "#6aaa6 bold"} {
+		if got, err := ParseStyle(in); err == nil {
+			t.Errorf("ParseStyle(%q) = %v, want an error", in, got)
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	green := Style{Color: RGB(0x6a, 0xaa, 0x64), Bold: true}
+	for _, test := range []struct {
+		style Style
+		depth Depth
+		want  string
+	}{
+		{Style{}, Palette, ""},
+		{green, NoColor, "\x1b[1m"},
+		{green, Basic, "\x1b[32m\x1b[1m"},
+		{green, Palette, "\x1b[38;5;71m\x1b[1m"},
+		// 24-bit colors can't be drawn, so they're matched to the palette
+		{green, TrueColor, "\x1b[38;5;71m\x1b[1m"},
+		{Style{Color: BasicColor(1, true), Underline: true, Reverse: true}, Basic, "\x1b[31;1m\x1b[4;7m"},
+		{Style{Color: PaletteColor(208)}, Palette, "\x1b[38;5;208m"},
+	} {
+		if got := test.style.Escape(test.depth); got != test.want {
+			t.Errorf("%v.Escape(%s) = %q, want %q", test.style, test.depth, got, test.want)
+		}
+	}
+}
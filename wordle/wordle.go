@@ -1,33 +1,277 @@
 package wordle
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 )
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
+// WordLength is the number of letters in targets and guesses unless a game
+// is created WithLength
+const WordLength = 5
+
+// MaxGuesses is the number of guesses allowed before the game is lost unless
+// a game is created WithTries
+const MaxGuesses = 6
+
+// Unlimited tries means the game can't be lost
+const Unlimited = -1
+
+var (
+	ErrGameOver      = errors.New("the game is already over")
+	ErrWrongLength   = errors.New("wrong number of letters")
+	ErrNotInWordList = errors.New("not in word list")
+)
+
+func getWord(rng *rand.Rand, length int) string {
+	answers := wordLists[length].answers
+	index := rng.Intn(len(answers))
+	return answers[index]
+}
+
+// State is where a game is in its lifecycle
+type State int
+
+const (
+	Playing State = iota
+	Won
+	Lost
+)
+
+func (s State) String() string {
+	switch s {
+	case Won:
+		return "won"
+	case Lost:
+		return "lost"
+	default:
+		return "playing"
+	}
 }
 
-func getWord() string {
-	index := rand.Intn(len(words))
-	return words[index]
+// Row is a submitted guess together with the feedback it received
+type Row struct {
+	Word     string
+	Feedback Feedback
 }
 
 type Wordle struct {
-	Target           string
-	Guesses          int
-	PreviousGuesses  []string
-	GameOver         bool
-	EnteredGibberish bool
+	length     int
+	tries      int
+	target     string
+	rows       []Row
+	state      State
+	difficulty Difficulty
+	mode       Mode
+	puzzle     int
+	seed       int64
+	rng        *rand.Rand
+	hints      int
+	lies       int
+	liar       *rand.Rand
+	// answers that are still possible while an adversarial game hasn't
+	// settled on a target
+	candidates []string
+	// seeded is false for games created WithSource, whose seed isn't known,
+	// and whose lies are seeded with liarSeed drawn from their source instead
+	seeded   bool
+	liarSeed int64
+}
+
+// Option configures a game created with New
+type Option func(*Wordle)
+
+// WithLength sets the number of letters in the target and guesses. It panics
+// if there is no word list for that length, see ValidLength.
+func WithLength(length int) Option {
+	if !ValidLength(length) {
+		panic(fmt.Sprintf("wordle: no word list for %d letter words", length))
+	}
+	return func(w *Wordle) {
+		w.length = length
+	}
+}
+
+// WithTries sets the number of guesses allowed before the game is lost, or
+// lets the game go on until the word is found if tries is Unlimited
+func WithTries(tries int) Option {
+	return func(w *Wordle) {
+		w.tries = tries
+	}
+}
+
+// WithSeed makes the random choices of the game, such as its practice target,
+// reproducible from seed
+func WithSeed(seed int64) Option {
+	return func(w *Wordle) {
+		w.seed, w.seeded = seed, true
+		w.rng = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithSource makes the game draw its random choices from src. Games sharing a
+// source will each get the next target in its sequence. The game has no seed
+// that could replay it, so it isn't given a number in share blocks.
+func WithSource(src rand.Source) Option {
+	return func(w *Wordle) {
+		w.seed, w.seeded = 0, false
+		w.rng = rand.New(src)
+	}
+}
+
+// WithDifficulty sets how strictly guesses have to follow revealed hints
+func WithDifficulty(d Difficulty) Option {
+	return func(w *Wordle) {
+		w.difficulty = d
+	}
 }
 
-func New() *Wordle {
+func New(opts ...Option) *Wordle {
 	w := Wordle{
-		Target:          getWord(),
-		Guesses:         0,
-		PreviousGuesses: make([]string, 0),
-		GameOver:        false,
+		length: WordLength,
+		tries:  MaxGuesses,
+		rows:   make([]Row, 0, MaxGuesses),
+		state:  Playing,
+	}
+	for _, opt := range opts {
+		opt(&w)
+	}
+	if w.rng == nil {
+		WithSeed(time.Now().UnixNano())(&w)
+	}
+	if !w.seeded && w.lies > 0 {
+		w.liarSeed = w.rng.Int63()
+	}
+	w.seedLiar(0)
+	switch w.mode {
+	case Daily:
+		w.target = puzzleWord(w.length, w.puzzle)
+	case Adversarial:
+		w.candidates = Answers(w.length)
+	default:
+		w.target = getWord(w.rng, w.length)
 	}
 	return &w
 }
+
+// Guess scores word against the target and records it. The game moves to Won
+// when the word matches and to Lost once every try has been used up. Words
+// that are the wrong length, not in the dictionary or that break a hard mode
+// rule are rejected without using up a guess.
+func (w *Wordle) Guess(word string) (Feedback, error) {
+	word = strings.ToLower(word)
+	if err := w.Check(word); err != nil {
+		return nil, err
+	}
+
+	var feedback Feedback
+	if w.target == "" {
+		feedback = w.dodge(word)
+	} else {
+		feedback = Score(word, w.target)
+	}
+	solved := feedback.Solved()
+	if w.lies > 0 && !solved {
+		w.lie(feedback)
+	}
+	w.rows = append(w.rows, Row{Word: word, Feedback: feedback})
+
+	if solved {
+		w.state = Won
+	} else if len(w.rows) == w.tries {
+		w.state = Lost
+		// an adversarial game that hasn't been forced to pick a target
+		// still needs one to reveal
+		if w.target == "" {
+			w.target = w.candidates[0]
+			w.candidates = nil
+		}
+	}
+	return feedback, nil
+}
+
+// Check reports why word would be rejected by Guess without submitting it.
+// Hard mode violations are returned as a *HardModeError.
+func (w *Wordle) Check(word string) error {
+	if w.state != Playing {
+		return ErrGameOver
+	}
+	if len(word) != w.length {
+		return ErrWrongLength
+	}
+	if !IsLegal(word) {
+		return ErrNotInWordList
+	}
+	if w.lies > 0 {
+		return nil
+	}
+	return CheckHints(word, w.rows, w.difficulty)
+}
+
+// Length returns the number of letters in the target
+func (w *Wordle) Length() int {
+	return w.length
+}
+
+// Tries returns the number of guesses allowed, or Unlimited
+func (w *Wordle) Tries() int {
+	return w.tries
+}
+
+// Target returns the hidden word. Adversarial games don't have one until
+// they're forced to pick it, and return "" until then.
+func (w *Wordle) Target() string {
+	return w.target
+}
+
+// Rows returns every guess made so far in order
+func (w *Wordle) Rows() []Row {
+	return w.rows
+}
+
+// Guesses returns the number of guesses made so far
+func (w *Wordle) Guesses() int {
+	return len(w.rows)
+}
+
+func (w *Wordle) Difficulty() Difficulty {
+	return w.difficulty
+}
+
+func (w *Wordle) Mode() Mode {
+	return w.mode
+}
+
+// Puzzle returns the number of the daily puzzle being played. It is only
+// meaningful when Mode is Daily.
+func (w *Wordle) Puzzle() int {
+	return w.puzzle
+}
+
+// UseHint records that the player asked for help, which is reflected in the
+// share grid and statistics of the game
+func (w *Wordle) UseHint() {
+	w.hints++
+}
+
+// Hints returns the number of hints used so far
+func (w *Wordle) Hints() int {
+	return w.hints
+}
+
+// Seed returns the seed the game's random choices were made from. It is 0
+// for games created WithSource.
+func (w *Wordle) Seed() int64 {
+	return w.seed
+}
+
+func (w *Wordle) State() State {
+	return w.state
+}
+
+// Over reports whether the game has been won or lost
+func (w *Wordle) Over() bool {
+	return w.state != Playing
+}
@@ -0,0 +1,176 @@
+package wordle
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	w := New(WithSeed(1))
+	tests := []struct {
+		word string
+		want error
+	}{
+		{"abc", ErrWrongLength},
+		{"abcdefg", ErrWrongLength},
+		{"xxxxx", ErrNotInWordList},
+		{"crane", nil},
+	}
+	for _, tt := range tests {
+		if err := w.Check(tt.word); err != tt.want {
+			t.Errorf("Check(%q) = %v, want %v", tt.word, err, tt.want)
+		}
+	}
+}
+
+func TestStates(t *testing.T) {
+	// seed 1 picks ledge
+	w := New(WithSeed(1), WithDifficulty(Hard))
+	if _, err := w.Guess("crane"); err != nil || w.State() != Playing {
+		t.Fatalf("Guess(crane) = %v, state %s, want nil and playing", err, w.State())
+	}
+	// rejected guesses don't use up a try
+	for _, tt := range []struct {
+		word string
+		want error
+	}{
+		{"abc", ErrWrongLength},
+		{"xxxxx", ErrNotInWordList},
+		// the E of crane was green
+		{"plaid", ErrHardMode},
+	} {
+		if _, err := w.Guess(tt.word); !errors.Is(err, tt.want) {
+			t.Errorf("Guess(%q) = %v, want %v", tt.word, err, tt.want)
+		}
+	}
+	if w.Guesses() != 1 || w.State() != Playing {
+		t.Errorf("after rejected guesses the game is %s with %d guesses, want playing with 1", w.State(), w.Guesses())
+	}
+	if feedback, err := w.Guess("LEDGE"); err != nil || !feedback.Solved() || w.State() != Won || !w.Over() {
+		t.Errorf("Guess(LEDGE) = %v, %v, state %s, want a solved row and won", feedback, err, w.State())
+	}
+	if _, err := w.Guess("crane"); err != ErrGameOver || w.Guesses() != 2 {
+		t.Errorf("Guess after winning = %v with %d guesses, want %v with 2", err, w.Guesses(), ErrGameOver)
+	}
+
+	w = New(WithSeed(1), WithTries(3))
+	for i, word := range []string{"crane", "plate", "mound"} {
+		if _, err := w.Guess(word); err != nil {
+			t.Fatal(err)
+		}
+		want := Playing
+		if i == 2 {
+			want = Lost
+		}
+		if w.State() != want {
+			t.Errorf("state after %d of 3 tries = %s, want %s", i+1, w.State(), want)
+		}
+	}
+	if _, err := w.Guess("ledge"); err != ErrGameOver || w.State() != Lost || w.Guesses() != 3 {
+		t.Errorf("Guess(ledge) after losing = %v, state %s with %d guesses, want %v, lost with 3", err, w.State(), w.Guesses(), ErrGameOver)
+	}
+}
+
+// a game with more than one board is won once every board is, and lost once
+// any board runs out of tries
+func TestGameStates(t *testing.T) {
+	g := NewGame(2, WithSeed(42), WithTries(3))
+	first, second := g.Boards()[0].Target(), g.Boards()[1].Target()
+	if _, err := g.Guess(first); err != nil {
+		t.Fatal(err)
+	}
+	if g.Boards()[0].State() != Won || g.State() != Playing {
+		t.Errorf("after solving the first board it's %s and the game %s, want won and playing", g.Boards()[0].State(), g.State())
+	}
+	if _, err := g.Guess("xxxxx"); err != ErrNotInWordList || g.Guesses() != 1 {
+		t.Errorf("Guess(xxxxx) = %v with %d guesses, want %v with 1", err, g.Guesses(), ErrNotInWordList)
+	}
+	if _, err := g.Guess(second); err != nil || g.State() != Won {
+		t.Errorf("Guess(%s) = %v, state %s, want won", second, err, g.State())
+	}
+	if _, err := g.Guess(first); err != ErrGameOver {
+		t.Errorf("Guess after winning = %v, want %v", err, ErrGameOver)
+	}
+
+	g = NewGame(2, WithSeed(42), WithTries(3))
+	for _, word := range []string{g.Boards()[0].Target(), "crane", "plate"} {
+		if _, err := g.Guess(word); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if g.State() != Lost || g.Boards()[0].State() != Won || g.Boards()[1].State() != Lost {
+		t.Errorf("after 3 tries the game is %s with boards %s and %s, want lost, won and lost", g.State(), g.Boards()[0].State(), g.Boards()[1].State())
+	}
+	if _, err := g.Guess(g.Boards()[1].Target()); err != ErrGameOver {
+		t.Errorf("Guess after losing = %v, want %v", err, ErrGameOver)
+	}
+}
+
+// targets picked from a seed must never change, since seeds are shared to
+// replay games
+func TestSeedTargets(t *testing.T) {
+	tests := []struct {
+		seed   int64
+		length int
+		want   string
+	}{
+		{1, 5, "ledge"},
+		{42, 5, "itchy"},
+		{2024, 5, "ovoid"},
+		{42, 6, "status"},
+		{2024, 6, "across"},
+	}
+	for _, tt := range tests {
+		if got := New(WithSeed(tt.seed), WithLength(tt.length)).Target(); got != tt.want {
+			t.Errorf("target of seed %d with %d letters = %q, want %q", tt.seed, tt.length, got, tt.want)
+		}
+	}
+
+	want := []string{"moody", "paper", "humus", "croup"}
+	for i, board := range NewGame(4, WithSeed(42)).Boards() {
+		if board.Target() != want[i] {
+			t.Errorf("target of board %d of seed 42 = %q, want %q", i, board.Target(), want[i])
+		}
+	}
+}
+
+func TestWithSource(t *testing.T) {
+	src := rand.NewSource(7)
+	first, second := New(WithSource(src)), New(WithSource(src))
+	if first.Target() != "curve" || second.Target() != "finer" {
+		t.Errorf("games sharing a source got %q and %q, want curve and finer", first.Target(), second.Target())
+	}
+
+	if _, err := first.Guess(first.Target()); err != nil {
+		t.Fatal(err)
+	}
+	header := strings.SplitN(first.Share(Classic), "\n", 2)[0]
+	if header != "Wordle practice 1/6" {
+		t.Errorf("share header of a game without a seed = %q, want no practice number", header)
+	}
+
+	// lies come from the source too, so games on sources with different
+	// seeds don't all lie the same way
+	lies := make(map[string]bool)
+	for seed := int64(0); seed < 10; seed++ {
+		w := New(WithSource(rand.NewSource(seed)), WithLies(2))
+		feedback, err := w.Guess("crane")
+		if err != nil {
+			t.Fatal(err)
+		}
+		truth := Score("crane", w.Target())
+		lying := make([]byte, len(feedback))
+		for i := range feedback {
+			lying[i] = '.'
+			if feedback[i] != truth[i] {
+				lying[i] = 'x'
+			}
+		}
+		lies[string(lying)] = true
+	}
+	if len(lies) < 2 {
+		t.Error("every game created WithSource lies the same way")
+	}
+}
@@ -0,0 +1,1412 @@
+package wordle
+
+// answers for 4 letter games, in the order daily puzzles use them
+var words4 = []string{
+	"nose",
+	"sort",
+	"city",
+	"heat",
+	"near",
+	"date",
+	"join",
+	"seek",
+	"rich",
+	"meet",
+	"half",
+	"hill",
+	"code",
+	"fort",
+	"cool",
+	"idea",
+	"much",
+	"bomb",
+	"this",
+	"mine",
+	"till",
+	"fear",
+	"wish",
+	"sole",
+	"army",
+	"very",
+	"paid",
+	"send",
+	"yeah",
+	"pick",
+	"ride",
+	"hole",
+	"read",
+	"mind",
+	"text",
+	"tell",
+	"boss",
+	"star",
+	"wide",
+	"soon",
+	"some",
+	"pull",
+	"yard",
+	"unit",
+	"line",
+	"rise",
+	"note",
+	"warm",
+	"tool",
+	"risk",
+	"well",
+	"bear",
+	"debt",
+	"save",
+	"huge",
+	"stop",
+	"rear",
+	"baby",
+	"lord",
+	"rest",
+	"cook",
+	"drop",
+	"mean",
+	"copy",
+	"shop",
+	"high",
+	"base",
+	"mere",
+	"term",
+	"crop",
+	"trip",
+	"here",
+	"rate",
+	"tune",
+	"soil",
+	"dose",
+	"sake",
+	"draw",
+	"jump",
+	"salt",
+	"look",
+	"mass",
+	"vast",
+	"deal",
+	"boat",
+	"miss",
+	"bulk",
+	"rely",
+	"evil",
+	"sick",
+	"part",
+	"hour",
+	"hire",
+	"land",
+	"golf",
+	"four",
+	"kill",
+	"wore",
+	"life",
+	"twin",
+	"loan",
+	"none",
+	"wear",
+	"knew",
+	"inch",
+	"feed",
+	"tank",
+	"gray",
+	"core",
+	"sand",
+	"tale",
+	"main",
+	"must",
+	"road",
+	"lack",
+	"safe",
+	"hurt",
+	"just",
+	"then",
+	"diet",
+	"slip",
+	"task",
+	"roll",
+	"menu",
+	"gate",
+	"burn",
+	"step",
+	"exit",
+	"path",
+	"song",
+	"pain",
+	"sale",
+	"host",
+	"soft",
+	"harm",
+	"free",
+	"came",
+	"acid",
+	"from",
+	"crew",
+	"male",
+	"true",
+	"disk",
+	"bush",
+	"town",
+	"more",
+	"each",
+	"open",
+	"shut",
+	"rose",
+	"fail",
+	"film",
+	"deny",
+	"cell",
+	"beer",
+	"will",
+	"need",
+	"farm",
+	"food",
+	"dust",
+	"side",
+	"page",
+	"held",
+	"blue",
+	"park",
+	"play",
+	"news",
+	"make",
+	"work",
+	"your",
+	"rush",
+	"feel",
+	"ward",
+	"time",
+	"mail",
+	"what",
+	"plus",
+	"aged",
+	"wife",
+	"late",
+	"duke",
+	"wood",
+	"bowl",
+	"when",
+	"spot",
+	"load",
+	"fire",
+	"shot",
+	"goal",
+	"hero",
+	"back",
+	"moon",
+	"away",
+	"over",
+	"went",
+	"like",
+	"tall",
+	"mill",
+	"zero",
+	"lose",
+	"team",
+	"vote",
+	"seen",
+	"full",
+	"coat",
+	"ease",
+	"joke",
+	"item",
+	"kick",
+	"long",
+	"bone",
+	"cost",
+	"tend",
+	"west",
+	"cake",
+	"were",
+	"able",
+	"keen",
+	"coal",
+	"thin",
+	"pair",
+	"hunt",
+	"zone",
+	"gave",
+	"neck",
+	"hair",
+	"help",
+	"suit",
+	"lead",
+	"beat",
+	"love",
+	"have",
+	"many",
+	"iron",
+	"born",
+	"walk",
+	"link",
+	"skin",
+	"bank",
+	"cash",
+	"head",
+	"grow",
+	"dawn",
+	"only",
+	"belt",
+	"onto",
+	"pipe",
+	"tour",
+	"tape",
+	"pool",
+	"dark",
+	"wild",
+	"such",
+	"with",
+	"same",
+	"once",
+	"oral",
+	"past",
+	"sure",
+	"glad",
+	"upon",
+	"wall",
+	"fact",
+	"than",
+	"case",
+	"vice",
+	"meal",
+	"plug",
+	"kept",
+	"come",
+	"gulf",
+	"body",
+	"hang",
+	"dead",
+	"wash",
+	"know",
+	"fell",
+	"pure",
+	"most",
+	"fine",
+	"flat",
+	"flow",
+	"slow",
+	"been",
+	"earn",
+	"meat",
+	"fall",
+	"lane",
+	"ring",
+	"role",
+	"lady",
+	"fast",
+	"poll",
+	"plan",
+	"rule",
+	"rail",
+	"find",
+	"soul",
+	"luck",
+	"even",
+	"kind",
+	"rock",
+	"move",
+	"ship",
+	"bell",
+	"said",
+	"user",
+	"year",
+	"mark",
+	"next",
+	"best",
+	"view",
+	"deep",
+	"peak",
+	"weak",
+	"bond",
+	"sign",
+	"wind",
+	"cold",
+	"wait",
+	"lake",
+	"face",
+	"lend",
+	"cast",
+	"pace",
+	"sell",
+	"home",
+	"take",
+	"down",
+	"live",
+	"tech",
+	"hope",
+	"area",
+	"tiny",
+	"sent",
+	"dirt",
+	"wave",
+	"into",
+	"five",
+	"data",
+	"told",
+	"lift",
+	"east",
+	"port",
+	"give",
+	"busy",
+	"post",
+	"king",
+	"rice",
+	"desk",
+	"snow",
+	"that",
+	"size",
+	"ball",
+	"hard",
+	"root",
+	"gear",
+	"calm",
+	"seat",
+	"sold",
+	"bill",
+	"week",
+	"lock",
+	"wake",
+	"drug",
+	"hate",
+	"cope",
+	"fill",
+	"poor",
+	"palm",
+	"gain",
+	"feet",
+	"self",
+	"boom",
+	"chat",
+	"hand",
+	"else",
+	"okay",
+	"rank",
+	"hall",
+	"pass",
+	"left",
+	"hold",
+	"duty",
+	"whom",
+	"less",
+	"rain",
+	"rare",
+	"ever",
+	"they",
+	"word",
+	"edge",
+	"room",
+	"dear",
+	"hell",
+	"seed",
+	"loss",
+	"rent",
+	"name",
+	"mode",
+	"tree",
+	"push",
+	"logo",
+	"blow",
+	"call",
+	"bird",
+	"foot",
+	"good",
+	"felt",
+	"dish",
+	"list",
+	"last",
+	"gene",
+	"pink",
+	"laid",
+	"knee",
+	"want",
+	"milk",
+	"wise",
+	"grey",
+	"seem",
+	"pack",
+	"girl",
+	"also",
+	"gold",
+	"nice",
+	"thus",
+	"card",
+	"game",
+	"lost",
+	"firm",
+	"dual",
+	"fish",
+	"mile",
+	"jail",
+	"roof",
+	"hear",
+	"plot",
+	"turn",
+	"camp",
+	"hung",
+	"type",
+	"used",
+	"site",
+	"wage",
+	"race",
+	"wire",
+	"grew",
+	"door",
+	"easy",
+	"fuel",
+	"wing",
+	"ford",
+	"them",
+	"real",
+	"took",
+	"bath",
+	"fair",
+	"form",
+	"club",
+	"tone",
+	"drew",
+	"talk",
+	"fund",
+	"holy",
+	"navy",
+	"mood",
+	"fate",
+	"book",
+	"stay",
+	"test",
+	"gift",
+	"wine",
+	"nine",
+	"keep",
+	"band",
+	"both",
+	"care",
+	"dial",
+	"made",
+	"jury",
+	"show",
+	"chip",
+	"gone",
+	"file",
+}
+
+// words accepted as 4 letter guesses on top of the answers, from the
+// sources listed in lists.go
+var extraWords4 = []string{
+	"abet",
+	"ache",
+	"acme",
+	"acne",
+	"acre",
+	"acts",
+	"adds",
+	"afar",
+	"ages",
+	"ahoy",
+	"aide",
+	"aids",
+	"airs",
+	"ajar",
+	"akin",
+	"alas",
+	"ally",
+	"alms",
+	"aloe",
+	"alto",
+	"amid",
+	"ammo",
+	"anew",
+	"ante",
+	"anti",
+	"apex",
+	"apps",
+	"apro",
+	"aqua",
+	"arch",
+	"arid",
+	"arms",
+	"arts",
+	"ashy",
+	"asks",
+	"atom",
+	"atop",
+	"aunt",
+	"aura",
+	"auto",
+	"avid",
+	"awry",
+	"axes",
+	"axis",
+	"axle",
+	"babe",
+	"bags",
+	"bail",
+	"bait",
+	"bake",
+	"bald",
+	"bale",
+	"balm",
+	"bane",
+	"bang",
+	"bare",
+	"barf",
+	"bark",
+	"barn",
+	"bars",
+	"bash",
+	"bass",
+	"bats",
+	"bead",
+	"beak",
+	"beam",
+	"bean",
+	"beds",
+	"beef",
+	"beep",
+	"bees",
+	"beet",
+	"bend",
+	"bent",
+	"bets",
+	"bevy",
+	"bias",
+	"bike",
+	"bile",
+	"bind",
+	"bins",
+	"bite",
+	"bits",
+	"blah",
+	"blew",
+	"blip",
+	"blob",
+	"bloc",
+	"blog",
+	"blot",
+	"blur",
+	"boar",
+	"boil",
+	"bold",
+	"bolt",
+	"bony",
+	"boot",
+	"bore",
+	"bout",
+	"bows",
+	"boxy",
+	"boys",
+	"brag",
+	"bran",
+	"bras",
+	"brat",
+	"brew",
+	"brim",
+	"buck",
+	"buff",
+	"bugs",
+	"bulb",
+	"bull",
+	"bump",
+	"bunk",
+	"bunt",
+	"buoy",
+	"burp",
+	"bury",
+	"bust",
+	"buys",
+	"buzz",
+	"cafe",
+	"cage",
+	"calf",
+	"cane",
+	"cant",
+	"cape",
+	"caps",
+	"carp",
+	"cars",
+	"cart",
+	"cats",
+	"cave",
+	"cent",
+	"chef",
+	"chin",
+	"chop",
+	"chow",
+	"chug",
+	"cite",
+	"clad",
+	"clam",
+	"clap",
+	"claw",
+	"clay",
+	"clip",
+	"clog",
+	"clot",
+	"clue",
+	"coax",
+	"coil",
+	"coin",
+	"coke",
+	"cola",
+	"colt",
+	"coma",
+	"comb",
+	"cone",
+	"cons",
+	"cord",
+	"cork",
+	"corn",
+	"cosy",
+	"cows",
+	"cozy",
+	"crab",
+	"crap",
+	"crib",
+	"crow",
+	"crux",
+	"cube",
+	"cuff",
+	"cult",
+	"cups",
+	"curb",
+	"cure",
+	"curl",
+	"cusp",
+	"cute",
+	"cuts",
+	"cyan",
+	"dads",
+	"daft",
+	"dame",
+	"damp",
+	"dare",
+	"darn",
+	"dart",
+	"dash",
+	"days",
+	"daze",
+	"deaf",
+	"dean",
+	"deck",
+	"deed",
+	"deem",
+	"deer",
+	"defy",
+	"dent",
+	"dice",
+	"died",
+	"dies",
+	"digs",
+	"dill",
+	"dime",
+	"dine",
+	"dire",
+	"disc",
+	"dive",
+	"dock",
+	"does",
+	"dogs",
+	"dole",
+	"doll",
+	"dome",
+	"done",
+	"doom",
+	"dork",
+	"doth",
+	"dots",
+	"dove",
+	"doze",
+	"drab",
+	"drag",
+	"dram",
+	"drip",
+	"drum",
+	"duck",
+	"duct",
+	"dude",
+	"duel",
+	"dull",
+	"duly",
+	"dumb",
+	"dump",
+	"dune",
+	"dunk",
+	"dupe",
+	"dusk",
+	"dyed",
+	"ears",
+	"eats",
+	"ebay",
+	"echo",
+	"eddy",
+	"edgy",
+	"eels",
+	"eggs",
+	"emit",
+	"ends",
+	"envy",
+	"epic",
+	"euro",
+	"exam",
+	"exes",
+	"eyed",
+	"eyes",
+	"fade",
+	"fake",
+	"fame",
+	"fang",
+	"fans",
+	"fare",
+	"fawn",
+	"faze",
+	"feat",
+	"fees",
+	"fern",
+	"feud",
+	"fife",
+	"fist",
+	"fits",
+	"flag",
+	"flap",
+	"flaw",
+	"flea",
+	"fled",
+	"flee",
+	"flip",
+	"flit",
+	"flog",
+	"flop",
+	"foam",
+	"foil",
+	"fold",
+	"folk",
+	"fond",
+	"font",
+	"fool",
+	"fork",
+	"foul",
+	"fowl",
+	"fray",
+	"fret",
+	"frog",
+	"fume",
+	"fury",
+	"fuse",
+	"fuss",
+	"fuzz",
+	"gala",
+	"gale",
+	"gall",
+	"gang",
+	"gaps",
+	"gasp",
+	"gawk",
+	"gaze",
+	"geek",
+	"gems",
+	"germ",
+	"gist",
+	"glee",
+	"glow",
+	"glue",
+	"gnat",
+	"gnaw",
+	"goat",
+	"goes",
+	"gong",
+	"goon",
+	"gore",
+	"gory",
+	"gosh",
+	"gout",
+	"gown",
+	"grab",
+	"grid",
+	"grim",
+	"grin",
+	"grip",
+	"grit",
+	"grub",
+	"gulp",
+	"gunk",
+	"guns",
+	"guru",
+	"gush",
+	"gust",
+	"guts",
+	"guys",
+	"hail",
+	"halt",
+	"hare",
+	"harp",
+	"hash",
+	"hath",
+	"hats",
+	"hawk",
+	"haze",
+	"hazy",
+	"heal",
+	"heap",
+	"heel",
+	"heir",
+	"helm",
+	"hens",
+	"herb",
+	"herd",
+	"hide",
+	"hike",
+	"hint",
+	"hits",
+	"hive",
+	"hoax",
+	"hoof",
+	"hook",
+	"hoop",
+	"horn",
+	"hose",
+	"huff",
+	"hugs",
+	"hula",
+	"hulk",
+	"hull",
+	"hump",
+	"hunk",
+	"hush",
+	"husk",
+	"hymn",
+	"iced",
+	"icky",
+	"icon",
+	"idle",
+	"idly",
+	"idol",
+	"info",
+	"inks",
+	"iota",
+	"ipad",
+	"ipod",
+	"itch",
+	"jade",
+	"jams",
+	"java",
+	"jaws",
+	"jazz",
+	"jeep",
+	"jerk",
+	"jest",
+	"jinx",
+	"jive",
+	"jobs",
+	"jolt",
+	"jowl",
+	"joys",
+	"judo",
+	"junk",
+	"kale",
+	"keel",
+	"kegs",
+	"kelp",
+	"keys",
+	"kids",
+	"kiln",
+	"kilt",
+	"kiss",
+	"kite",
+	"kiwi",
+	"knit",
+	"knob",
+	"knot",
+	"kung",
+	"lace",
+	"lags",
+	"lair",
+	"lamb",
+	"lame",
+	"lamp",
+	"lard",
+	"lark",
+	"lash",
+	"lava",
+	"lawn",
+	"lays",
+	"lazy",
+	"leaf",
+	"leak",
+	"lean",
+	"leap",
+	"legs",
+	"lens",
+	"lent",
+	"lest",
+	"lets",
+	"liar",
+	"lick",
+	"lied",
+	"lies",
+	"lieu",
+	"lily",
+	"limb",
+	"lime",
+	"limp",
+	"lint",
+	"lion",
+	"lips",
+	"lisp",
+	"loaf",
+	"loft",
+	"logs",
+	"lone",
+	"loom",
+	"loop",
+	"loot",
+	"lore",
+	"lots",
+	"loud",
+	"lump",
+	"lung",
+	"lure",
+	"lurk",
+	"lush",
+	"lute",
+	"mace",
+	"maid",
+	"mall",
+	"malt",
+	"mama",
+	"mane",
+	"maps",
+	"mare",
+	"mash",
+	"mask",
+	"mast",
+	"mate",
+	"math",
+	"maze",
+	"mead",
+	"meek",
+	"melt",
+	"memo",
+	"mesh",
+	"mess",
+	"meth",
+	"mild",
+	"mime",
+	"mini",
+	"mint",
+	"mist",
+	"moan",
+	"moat",
+	"mobs",
+	"mock",
+	"mold",
+	"mole",
+	"molt",
+	"moms",
+	"monk",
+	"mono",
+	"moot",
+	"moss",
+	"moth",
+	"muck",
+	"mugs",
+	"mule",
+	"mush",
+	"musk",
+	"mute",
+	"mutt",
+	"myth",
+	"nail",
+	"nape",
+	"naps",
+	"neat",
+	"neon",
+	"nerd",
+	"nest",
+	"nets",
+	"newt",
+	"nook",
+	"nope",
+	"norm",
+	"noun",
+	"numb",
+	"oath",
+	"oats",
+	"obey",
+	"oboe",
+	"odds",
+	"odor",
+	"ogle",
+	"oils",
+	"oily",
+	"oink",
+	"omen",
+	"omit",
+	"ones",
+	"onyx",
+	"oops",
+	"ooze",
+	"oozy",
+	"opal",
+	"ouch",
+	"ours",
+	"outs",
+	"oval",
+	"oven",
+	"owls",
+	"owns",
+	"pact",
+	"pads",
+	"pail",
+	"pale",
+	"pals",
+	"pane",
+	"pang",
+	"pant",
+	"pave",
+	"pawn",
+	"pays",
+	"peal",
+	"pear",
+	"peat",
+	"peck",
+	"peel",
+	"peep",
+	"peer",
+	"pelt",
+	"pens",
+	"perk",
+	"perm",
+	"peso",
+	"pest",
+	"pets",
+	"phew",
+	"pier",
+	"pigs",
+	"pike",
+	"pile",
+	"pine",
+	"pins",
+	"pint",
+	"pity",
+	"plea",
+	"plod",
+	"plop",
+	"plow",
+	"ploy",
+	"plum",
+	"pods",
+	"poem",
+	"poet",
+	"pogo",
+	"pole",
+	"polo",
+	"pond",
+	"pony",
+	"pope",
+	"pops",
+	"pore",
+	"pork",
+	"pose",
+	"posh",
+	"pour",
+	"pout",
+	"pray",
+	"prep",
+	"prey",
+	"prod",
+	"prom",
+	"prop",
+	"pros",
+	"prow",
+	"puff",
+	"pulp",
+	"puma",
+	"pump",
+	"punk",
+	"puny",
+	"pupa",
+	"purr",
+	"puts",
+	"putt",
+	"quay",
+	"quit",
+	"quiz",
+	"rack",
+	"raft",
+	"rage",
+	"raid",
+	"rake",
+	"ramp",
+	"rant",
+	"rash",
+	"rasp",
+	"ream",
+	"reap",
+	"redo",
+	"reed",
+	"reef",
+	"reek",
+	"reel",
+	"rein",
+	"reps",
+	"ribs",
+	"rift",
+	"rind",
+	"rink",
+	"riot",
+	"ripe",
+	"roam",
+	"roar",
+	"robe",
+	"rode",
+	"romp",
+	"rope",
+	"rosy",
+	"rows",
+	"ruby",
+	"rude",
+	"rugs",
+	"ruin",
+	"rune",
+	"rung",
+	"runs",
+	"runt",
+	"ruse",
+	"rust",
+	"sack",
+	"saga",
+	"sage",
+	"sail",
+	"sane",
+	"sank",
+	"sari",
+	"sash",
+	"says",
+	"scab",
+	"scam",
+	"scan",
+	"scar",
+	"seal",
+	"seam",
+	"sear",
+	"sect",
+	"sees",
+	"sets",
+	"sewn",
+	"sews",
+	"shed",
+	"shin",
+	"shoe",
+	"shun",
+	"sift",
+	"sigh",
+	"silk",
+	"sill",
+	"silo",
+	"silt",
+	"sing",
+	"sink",
+	"sips",
+	"sire",
+	"sits",
+	"skid",
+	"skip",
+	"skis",
+	"slab",
+	"slam",
+	"slap",
+	"slaw",
+	"sled",
+	"slid",
+	"slim",
+	"slit",
+	"slop",
+	"slot",
+	"slug",
+	"slum",
+	"smog",
+	"snag",
+	"snap",
+	"snip",
+	"snob",
+	"snub",
+	"snug",
+	"soak",
+	"soap",
+	"soar",
+	"sock",
+	"soda",
+	"sofa",
+	"sons",
+	"soot",
+	"soup",
+	"sour",
+	"span",
+	"spar",
+	"spec",
+	"sped",
+	"spew",
+	"spin",
+	"spit",
+	"spry",
+	"spud",
+	"spur",
+	"stab",
+	"stat",
+	"stem",
+	"stew",
+	"stir",
+	"stub",
+	"stud",
+	"stun",
+	"subs",
+	"suds",
+	"sulk",
+	"sung",
+	"sunk",
+	"suns",
+	"swab",
+	"swan",
+	"swap",
+	"sway",
+	"swim",
+	"tabs",
+	"tack",
+	"taco",
+	"tact",
+	"tags",
+	"tail",
+	"tame",
+	"tang",
+	"taps",
+	"tarp",
+	"tart",
+	"taut",
+	"taxi",
+	"teak",
+	"teal",
+	"tear",
+	"teas",
+	"teem",
+	"teen",
+	"tens",
+	"tent",
+	"thaw",
+	"thee",
+	"thou",
+	"thud",
+	"tick",
+	"tide",
+	"tidy",
+	"tied",
+	"tier",
+	"ties",
+	"tile",
+	"tilt",
+	"tint",
+	"tips",
+	"tire",
+	"toad",
+	"toes",
+	"tofu",
+	"toga",
+	"toil",
+	"tomb",
+	"tons",
+	"tops",
+	"tore",
+	"torn",
+	"toss",
+	"tout",
+	"toys",
+	"tram",
+	"trap",
+	"tray",
+	"trim",
+	"trio",
+	"trod",
+	"tuba",
+	"tuck",
+	"tuft",
+	"turf",
+	"tusk",
+	"tutu",
+	"twig",
+	"tyke",
+	"typo",
+	"ugly",
+	"undo",
+	"unto",
+	"urge",
+	"uses",
+	"vain",
+	"vane",
+	"vase",
+	"veal",
+	"veil",
+	"vein",
+	"vent",
+	"verb",
+	"vest",
+	"veto",
+	"vial",
+	"vine",
+	"visa",
+	"void",
+	"vole",
+	"wade",
+	"wads",
+	"waft",
+	"wail",
+	"wand",
+	"wane",
+	"warn",
+	"wart",
+	"wary",
+	"wasp",
+	"wavy",
+	"ways",
+	"weed",
+	"weep",
+	"weld",
+	"welt",
+	"wham",
+	"whim",
+	"whip",
+	"whiz",
+	"wick",
+	"wifi",
+	"wilt",
+	"wimp",
+	"wink",
+	"wins",
+	"wipe",
+	"wiry",
+	"wisp",
+	"wits",
+	"woke",
+	"wolf",
+	"womb",
+	"woof",
+	"wool",
+	"worm",
+	"worn",
+	"wrap",
+	"wren",
+	"xbox",
+	"yams",
+	"yank",
+	"yarn",
+	"yawn",
+	"yell",
+	"yelp",
+	"yoga",
+	"yolk",
+	"yoyo",
+	"zeal",
+	"zeta",
+	"zinc",
+	"zing",
+	"zips",
+	"zoom",
+	"zoos",
+}
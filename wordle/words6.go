@@ -0,0 +1,2916 @@
+package wordle
+
+// answers for 6 letter games, in the order daily puzzles use them
+var words6 = []string{
+	"moment",
+	"facing",
+	"travel",
+	"corner",
+	"plenty",
+	"school",
+	"killed",
+	"device",
+	"motion",
+	"rescue",
+	"narrow",
+	"offset",
+	"threat",
+	"emerge",
+	"spirit",
+	"nearly",
+	"broken",
+	"export",
+	"bottom",
+	"anyone",
+	"salary",
+	"doctor",
+	"format",
+	"fairly",
+	"ensure",
+	"select",
+	"league",
+	"robust",
+	"expect",
+	"winter",
+	"proper",
+	"intent",
+	"border",
+	"fiscal",
+	"choose",
+	"agency",
+	"region",
+	"estate",
+	"street",
+	"failed",
+	"figure",
+	"assume",
+	"riding",
+	"author",
+	"nobody",
+	"decade",
+	"modest",
+	"around",
+	"decide",
+	"design",
+	"detail",
+	"period",
+	"artist",
+	"finger",
+	"column",
+	"chosen",
+	"latter",
+	"remote",
+	"barely",
+	"silver",
+	"active",
+	"listen",
+	"murder",
+	"equity",
+	"wholly",
+	"button",
+	"editor",
+	"defend",
+	"happen",
+	"random",
+	"animal",
+	"rising",
+	"demand",
+	"breath",
+	"regard",
+	"nature",
+	"define",
+	"assess",
+	"handle",
+	"origin",
+	"belong",
+	"action",
+	"choice",
+	"margin",
+	"saving",
+	"carbon",
+	"tennis",
+	"repair",
+	"career",
+	"shadow",
+	"hardly",
+	"driven",
+	"merger",
+	"reward",
+	"member",
+	"castle",
+	"dealer",
+	"expand",
+	"ethnic",
+	"desire",
+	"search",
+	"backed",
+	"copper",
+	"versus",
+	"branch",
+	"lights",
+	"united",
+	"family",
+	"matter",
+	"suffer",
+	"ground",
+	"secure",
+	"appeal",
+	"unable",
+	"stable",
+	"strain",
+	"fellow",
+	"coffee",
+	"policy",
+	"invest",
+	"forest",
+	"growth",
+	"letter",
+	"method",
+	"reason",
+	"burden",
+	"really",
+	"charge",
+	"summit",
+	"debate",
+	"gentle",
+	"parent",
+	"regime",
+	"steady",
+	"arrive",
+	"gender",
+	"though",
+	"differ",
+	"public",
+	"online",
+	"profit",
+	"foster",
+	"extend",
+	"escape",
+	"memory",
+	"finish",
+	"return",
+	"casual",
+	"unless",
+	"varied",
+	"simply",
+	"picked",
+	"enable",
+	"medium",
+	"single",
+	"across",
+	"output",
+	"follow",
+	"create",
+	"pocket",
+	"switch",
+	"treaty",
+	"filing",
+	"access",
+	"enough",
+	"energy",
+	"thanks",
+	"client",
+	"wonder",
+	"survey",
+	"giving",
+	"engage",
+	"bridge",
+	"writer",
+	"worker",
+	"engine",
+	"during",
+	"flying",
+	"planet",
+	"island",
+	"museum",
+	"effort",
+	"tender",
+	"script",
+	"winner",
+	"comply",
+	"window",
+	"attend",
+	"golden",
+	"relief",
+	"double",
+	"pretty",
+	"moving",
+	"autumn",
+	"employ",
+	"struck",
+	"office",
+	"female",
+	"people",
+	"became",
+	"mobile",
+	"coming",
+	"system",
+	"flight",
+	"minute",
+	"partly",
+	"gather",
+	"honest",
+	"agenda",
+	"impact",
+	"review",
+	"secret",
+	"behind",
+	"mother",
+	"itself",
+	"fourth",
+	"wealth",
+	"solely",
+	"senior",
+	"exceed",
+	"headed",
+	"strong",
+	"legacy",
+	"seeing",
+	"length",
+	"course",
+	"deputy",
+	"degree",
+	"dinner",
+	"mature",
+	"center",
+	"lovely",
+	"inside",
+	"rather",
+	"anyway",
+	"second",
+	"costly",
+	"modern",
+	"obtain",
+	"reader",
+	"handed",
+	"holder",
+	"amount",
+	"rarely",
+	"yellow",
+	"mutual",
+	"smooth",
+	"ending",
+	"server",
+	"manual",
+	"safety",
+	"direct",
+	"depend",
+	"simple",
+	"leader",
+	"season",
+	"volume",
+	"afford",
+	"marked",
+	"proven",
+	"timber",
+	"closed",
+	"before",
+	"cannot",
+	"object",
+	"avenue",
+	"lesson",
+	"living",
+	"submit",
+	"bought",
+	"retail",
+	"answer",
+	"chance",
+	"extent",
+	"dollar",
+	"weight",
+	"stream",
+	"notice",
+	"bottle",
+	"detect",
+	"walker",
+	"battle",
+	"scheme",
+	"sister",
+	"reveal",
+	"advice",
+	"police",
+	"church",
+	"replay",
+	"attack",
+	"prefer",
+	"thrown",
+	"fought",
+	"bright",
+	"normal",
+	"always",
+	"county",
+	"assist",
+	"launch",
+	"acting",
+	"native",
+	"should",
+	"circle",
+	"recent",
+	"retain",
+	"victim",
+	"reduce",
+	"frozen",
+	"closer",
+	"former",
+	"factor",
+	"likely",
+	"timing",
+	"marine",
+	"sample",
+	"centre",
+	"manner",
+	"losing",
+	"little",
+	"report",
+	"silent",
+	"within",
+	"phrase",
+	"import",
+	"height",
+	"easily",
+	"twenty",
+	"fallen",
+	"record",
+	"status",
+	"eating",
+	"sector",
+	"strike",
+	"sought",
+	"taking",
+	"camera",
+	"unique",
+	"vendor",
+	"affect",
+	"common",
+	"strict",
+	"lawyer",
+	"either",
+	"trying",
+	"behalf",
+	"target",
+	"summer",
+	"liquid",
+	"injury",
+	"merely",
+	"beauty",
+	"severe",
+	"repeat",
+	"latest",
+	"visual",
+	"taught",
+	"social",
+	"useful",
+	"junior",
+	"sudden",
+	"guilty",
+	"packed",
+	"income",
+	"couple",
+	"aspect",
+	"valley",
+	"slight",
+	"cancer",
+	"entire",
+	"global",
+	"mirror",
+	"future",
+	"vision",
+	"excuse",
+	"player",
+	"please",
+	"reform",
+	"nation",
+	"famous",
+	"raised",
+	"advise",
+	"combat",
+	"covers",
+	"actual",
+	"middle",
+	"stolen",
+	"defeat",
+	"palace",
+	"caught",
+	"desert",
+	"luxury",
+	"garden",
+	"surely",
+	"ruling",
+	"expert",
+	"eleven",
+	"ticket",
+	"permit",
+	"budget",
+	"update",
+	"mining",
+	"relate",
+	"market",
+	"thirty",
+	"bureau",
+	"studio",
+	"forced",
+	"except",
+	"intend",
+	"patent",
+	"domain",
+	"tenant",
+	"prince",
+	"almost",
+	"master",
+	"talent",
+	"resort",
+	"pursue",
+	"number",
+	"mental",
+	"crisis",
+	"making",
+	"forget",
+	"rating",
+	"string",
+	"danger",
+	"orange",
+	"appear",
+	"bishop",
+	"entity",
+	"speech",
+	"prison",
+	"symbol",
+	"custom",
+	"seller",
+	"twelve",
+	"toward",
+	"labour",
+	"driver",
+	"tissue",
+	"health",
+	"spoken",
+	"formal",
+	"better",
+	"father",
+	"indeed",
+	"supply",
+	"belief",
+	"remove",
+	"person",
+	"spring",
+	"weekly",
+	"eighth",
+	"unlike",
+	"square",
+	"result",
+	"myself",
+	"nearby",
+	"credit",
+	"beyond",
+	"remain",
+	"mostly",
+	"monkey",
+	"hidden",
+	"theory",
+	"saying",
+	"damage",
+	"spread",
+	"manage",
+	"empire",
+	"afraid",
+	"signal",
+	"fabric",
+	"friend",
+	"screen",
+	"series",
+	"linked",
+	"option",
+	"recall",
+	"change",
+	"accept",
+	"annual",
+	"effect",
+	"settle",
+	"mainly",
+	"become",
+	"stress",
+	"notion",
+	"source",
+}
+
+// words accepted as 6 letter guesses on top of the answers, from the
+// sources listed in lists.go
+var extraWords6 = []string{
+	"abacus",
+	"ablaze",
+	"aboard",
+	"abroad",
+	"abrupt",
+	"absent",
+	"absorb",
+	"absurd",
+	"abused",
+	"accent",
+	"aching",
+	"acquit",
+	"actors",
+	"adding",
+	"adhere",
+	"adjust",
+	"admire",
+	"admits",
+	"adored",
+	"adores",
+	"adrift",
+	"adults",
+	"aerial",
+	"affair",
+	"affirm",
+	"aflame",
+	"afloat",
+	"agents",
+	"aghast",
+	"agreed",
+	"agrees",
+	"aiding",
+	"ailing",
+	"aiming",
+	"aisles",
+	"alarms",
+	"albeit",
+	"albums",
+	"alibis",
+	"aliens",
+	"alleys",
+	"allied",
+	"allies",
+	"allows",
+	"alpine",
+	"alters",
+	"alumni",
+	"amazed",
+	"amazes",
+	"ambush",
+	"amends",
+	"amulet",
+	"amused",
+	"amuser",
+	"anchor",
+	"anemia",
+	"anemic",
+	"angers",
+	"angled",
+	"angler",
+	"angles",
+	"ankles",
+	"annoys",
+	"anoint",
+	"anthem",
+	"antics",
+	"antler",
+	"anyhow",
+	"apathy",
+	"apples",
+	"arcade",
+	"arches",
+	"ardent",
+	"argued",
+	"armful",
+	"armies",
+	"arming",
+	"armory",
+	"arrest",
+	"arrows",
+	"ascend",
+	"ascent",
+	"ashore",
+	"asking",
+	"asleep",
+	"aspire",
+	"assert",
+	"assets",
+	"assign",
+	"assure",
+	"astray",
+	"astute",
+	"atrium",
+	"attach",
+	"attain",
+	"attest",
+	"attire",
+	"autism",
+	"avatar",
+	"avenge",
+	"avoids",
+	"awaits",
+	"awaken",
+	"awards",
+	"awhile",
+	"awning",
+	"babble",
+	"babied",
+	"babies",
+	"baboon",
+	"backer",
+	"backup",
+	"badass",
+	"badges",
+	"baffle",
+	"bagful",
+	"bagged",
+	"baggie",
+	"bailed",
+	"bakery",
+	"baking",
+	"ballot",
+	"bamboo",
+	"banana",
+	"bandit",
+	"banged",
+	"banish",
+	"banked",
+	"banker",
+	"banner",
+	"banter",
+	"barbed",
+	"barber",
+	"barfed",
+	"barged",
+	"barley",
+	"barman",
+	"barred",
+	"barrel",
+	"bashed",
+	"basics",
+	"basing",
+	"basket",
+	"batboy",
+	"bathed",
+	"bathes",
+	"batter",
+	"bauble",
+	"beacon",
+	"beaker",
+	"beamed",
+	"beards",
+	"bearer",
+	"beasts",
+	"beaten",
+	"beeped",
+	"beeper",
+	"begins",
+	"behave",
+	"beings",
+	"bellow",
+	"belted",
+	"benign",
+	"beside",
+	"bested",
+	"betray",
+	"bevies",
+	"biased",
+	"bibles",
+	"biceps",
+	"bigger",
+	"biking",
+	"bikini",
+	"billed",
+	"bimbos",
+	"binary",
+	"biting",
+	"bitter",
+	"blacks",
+	"blamed",
+	"blames",
+	"blazer",
+	"blazes",
+	"bleach",
+	"bleeds",
+	"blinds",
+	"blinks",
+	"blocks",
+	"blokes",
+	"blonde",
+	"bloods",
+	"bloody",
+	"blouse",
+	"blower",
+	"bluish",
+	"blurry",
+	"boards",
+	"bobbed",
+	"bobble",
+	"bobcat",
+	"bodied",
+	"bodies",
+	"bogged",
+	"boggle",
+	"boiled",
+	"bolted",
+	"bombed",
+	"bonded",
+	"bonnet",
+	"bonsai",
+	"booked",
+	"booted",
+	"booths",
+	"bootie",
+	"boring",
+	"bosses",
+	"botany",
+	"bother",
+	"bounce",
+	"bouncy",
+	"bounty",
+	"bovine",
+	"bowels",
+	"bowing",
+	"boxcar",
+	"boxers",
+	"boxing",
+	"braces",
+	"brains",
+	"brainy",
+	"brakes",
+	"braver",
+	"breach",
+	"breads",
+	"breaks",
+	"breeds",
+	"breeze",
+	"breezy",
+	"brewed",
+	"bribed",
+	"bribes",
+	"brides",
+	"bridle",
+	"briefs",
+	"brings",
+	"broads",
+	"broker",
+	"bronco",
+	"bronze",
+	"browse",
+	"brunch",
+	"brutal",
+	"bubble",
+	"bubbly",
+	"bucked",
+	"bucket",
+	"buckle",
+	"buffed",
+	"buffer",
+	"builds",
+	"bulgur",
+	"bumped",
+	"bumper",
+	"bundle",
+	"bundys",
+	"bungee",
+	"bunion",
+	"burger",
+	"buried",
+	"buries",
+	"burned",
+	"burrow",
+	"bursts",
+	"busboy",
+	"bushes",
+	"busily",
+	"busted",
+	"butted",
+	"butter",
+	"buyers",
+	"buying",
+	"buzzed",
+	"bylaws",
+	"bypass",
+	"cabana",
+	"cabbie",
+	"cabins",
+	"cables",
+	"cached",
+	"cackle",
+	"cactus",
+	"caddie",
+	"cadets",
+	"called",
+	"caller",
+	"calmed",
+	"calmer",
+	"calmly",
+	"calves",
+	"camped",
+	"camper",
+	"campus",
+	"canals",
+	"canary",
+	"cancel",
+	"candle",
+	"canine",
+	"canned",
+	"cannon",
+	"canoes",
+	"canola",
+	"canopy",
+	"canvas",
+	"canyon",
+	"capped",
+	"carded",
+	"caress",
+	"caring",
+	"carols",
+	"carpet",
+	"carrot",
+	"carted",
+	"cartel",
+	"carton",
+	"carved",
+	"cashed",
+	"casing",
+	"casino",
+	"casket",
+	"catchy",
+	"catnap",
+	"catnip",
+	"catsup",
+	"cattle",
+	"caucus",
+	"causal",
+	"caused",
+	"causes",
+	"caviar",
+	"caving",
+	"cavity",
+	"ceased",
+	"ceases",
+	"cedars",
+	"celery",
+	"cellar",
+	"cellos",
+	"celtic",
+	"cement",
+	"census",
+	"cereal",
+	"chairs",
+	"chalet",
+	"charms",
+	"charts",
+	"chased",
+	"chaser",
+	"chases",
+	"chaste",
+	"chatty",
+	"cheats",
+	"checks",
+	"cheeks",
+	"cheese",
+	"cheesy",
+	"cherry",
+	"cherub",
+	"chests",
+	"chewer",
+	"chiefs",
+	"chills",
+	"chimes",
+	"chirpy",
+	"choirs",
+	"choked",
+	"choker",
+	"choosy",
+	"chords",
+	"chores",
+	"chorus",
+	"chrome",
+	"chubby",
+	"chummy",
+	"chunks",
+	"cinema",
+	"circus",
+	"cities",
+	"citric",
+	"citrus",
+	"civics",
+	"clader",
+	"claims",
+	"clammy",
+	"clamor",
+	"clamps",
+	"clause",
+	"cleans",
+	"clears",
+	"clench",
+	"clergy",
+	"clerks",
+	"clever",
+	"clicks",
+	"cliffs",
+	"climbs",
+	"clinic",
+	"clique",
+	"clocks",
+	"cloned",
+	"closes",
+	"closet",
+	"clouds",
+	"clover",
+	"clumps",
+	"clumsy",
+	"clunky",
+	"clutch",
+	"coarse",
+	"coated",
+	"cobalt",
+	"cobweb",
+	"cocoon",
+	"coding",
+	"coerce",
+	"coffin",
+	"colder",
+	"collar",
+	"collie",
+	"colony",
+	"combed",
+	"comedy",
+	"commit",
+	"compel",
+	"concur",
+	"condos",
+	"conned",
+	"convey",
+	"cooked",
+	"cooker",
+	"cookie",
+	"cooled",
+	"copied",
+	"copier",
+	"copies",
+	"coping",
+	"cornea",
+	"corned",
+	"corpse",
+	"corral",
+	"corset",
+	"cortex",
+	"cosmic",
+	"cosmos",
+	"cotton",
+	"coughs",
+	"courts",
+	"cousin",
+	"coveys",
+	"cowboy",
+	"cozily",
+	"cracks",
+	"cradle",
+	"crafts",
+	"crafty",
+	"cranes",
+	"crater",
+	"crates",
+	"cravat",
+	"craves",
+	"crawls",
+	"crayon",
+	"crazed",
+	"crease",
+	"creeps",
+	"creole",
+	"crests",
+	"crimes",
+	"cringe",
+	"crises",
+	"crispy",
+	"crouch",
+	"crowds",
+	"crummy",
+	"crunch",
+	"crusts",
+	"crutch",
+	"crying",
+	"cuddle",
+	"cuddly",
+	"cuffed",
+	"cupped",
+	"curdle",
+	"curfew",
+	"curing",
+	"curled",
+	"curler",
+	"cursed",
+	"curses",
+	"cursor",
+	"curtly",
+	"curtsy",
+	"curves",
+	"cussed",
+	"cutest",
+	"cycles",
+	"cyclic",
+	"cymbal",
+	"dagger",
+	"dainty",
+	"damper",
+	"danced",
+	"dances",
+	"dander",
+	"dangle",
+	"daring",
+	"darken",
+	"darker",
+	"darned",
+	"dashed",
+	"dating",
+	"dawned",
+	"daybed",
+	"dazzle",
+	"deacon",
+	"deader",
+	"deadly",
+	"dearly",
+	"deaths",
+	"debris",
+	"debtor",
+	"debunk",
+	"deceit",
+	"decent",
+	"decked",
+	"decode",
+	"decree",
+	"deduce",
+	"deduct",
+	"deemed",
+	"deepen",
+	"deeper",
+	"deeply",
+	"deface",
+	"defame",
+	"defect",
+	"defied",
+	"defies",
+	"defile",
+	"deftly",
+	"defuse",
+	"delays",
+	"delete",
+	"deluge",
+	"deluxe",
+	"delves",
+	"demise",
+	"demons",
+	"demote",
+	"denial",
+	"denied",
+	"denies",
+	"denote",
+	"dental",
+	"dented",
+	"depict",
+	"deploy",
+	"deport",
+	"depose",
+	"depths",
+	"derail",
+	"derive",
+	"detest",
+	"deuces",
+	"dialed",
+	"diaper",
+	"dicing",
+	"digest",
+	"digits",
+	"dilute",
+	"dimmed",
+	"dimmer",
+	"dimple",
+	"diners",
+	"dinghy",
+	"dining",
+	"dipped",
+	"dipper",
+	"disarm",
+	"dishes",
+	"dismal",
+	"dismay",
+	"disown",
+	"divert",
+	"diving",
+	"doable",
+	"docile",
+	"docked",
+	"dodged",
+	"dogged",
+	"doling",
+	"dolled",
+	"dollop",
+	"donate",
+	"donkey",
+	"donors",
+	"doodle",
+	"doomed",
+	"dorsal",
+	"dosage",
+	"dotted",
+	"doubly",
+	"doubts",
+	"douche",
+	"dowser",
+	"dozens",
+	"drafts",
+	"dragon",
+	"drains",
+	"dramas",
+	"draped",
+	"drapes",
+	"drawer",
+	"dreams",
+	"dreamt",
+	"dreamy",
+	"dreary",
+	"drench",
+	"drills",
+	"drinks",
+	"drippy",
+	"drives",
+	"drones",
+	"drudge",
+	"drunks",
+	"dryers",
+	"drying",
+	"dubbed",
+	"ducked",
+	"duffel",
+	"dugout",
+	"duller",
+	"dulles",
+	"dumber",
+	"dumped",
+	"dumper",
+	"duplex",
+	"duress",
+	"dusted",
+	"duties",
+	"dynamo",
+	"earful",
+	"earned",
+	"earses",
+	"earthy",
+	"earwig",
+	"easier",
+	"easing",
+	"eaters",
+	"eatery",
+	"echoes",
+	"eclair",
+	"edging",
+	"edible",
+	"edited",
+	"effigy",
+	"egging",
+	"eggnog",
+	"eights",
+	"elapse",
+	"elated",
+	"elbows",
+	"elders",
+	"eldest",
+	"elixir",
+	"eloped",
+	"eluded",
+	"embark",
+	"emblem",
+	"embody",
+	"emboss",
+	"embryo",
+	"enamel",
+	"encode",
+	"encore",
+	"endure",
+	"engulf",
+	"enigma",
+	"enjoys",
+	"enlist",
+	"enrage",
+	"enrich",
+	"enroll",
+	"entail",
+	"entice",
+	"entomb",
+	"entrap",
+	"entree",
+	"envied",
+	"envies",
+	"enzyme",
+	"equals",
+	"equate",
+	"erased",
+	"eraser",
+	"errand",
+	"errant",
+	"escrow",
+	"eskimo",
+	"essays",
+	"ethics",
+	"evenly",
+	"events",
+	"evolve",
+	"excels",
+	"excess",
+	"exempt",
+	"exhale",
+	"exhume",
+	"exiled",
+	"exists",
+	"exited",
+	"exodus",
+	"exotic",
+	"expels",
+	"expend",
+	"expire",
+	"expose",
+	"extras",
+	"eyeing",
+	"eyeses",
+	"facade",
+	"facial",
+	"fading",
+	"faking",
+	"falcon",
+	"famine",
+	"faster",
+	"faucet",
+	"faults",
+	"faulty",
+	"favors",
+	"favour",
+	"feared",
+	"fedora",
+	"feeble",
+	"feeder",
+	"feisty",
+	"feline",
+	"fellas",
+	"felons",
+	"femmes",
+	"fences",
+	"fender",
+	"ferret",
+	"fervor",
+	"fester",
+	"fibers",
+	"fickle",
+	"fiddle",
+	"fields",
+	"fiends",
+	"fierce",
+	"fights",
+	"filled",
+	"filler",
+	"filmed",
+	"filter",
+	"finale",
+	"finals",
+	"finely",
+	"finest",
+	"finite",
+	"firing",
+	"firmly",
+	"fished",
+	"fishes",
+	"fitted",
+	"fixing",
+	"flaked",
+	"flakes",
+	"flared",
+	"flares",
+	"flashy",
+	"flatly",
+	"flavor",
+	"flawed",
+	"fleece",
+	"fleets",
+	"fleshy",
+	"flicks",
+	"fliers",
+	"flimsy",
+	"flinch",
+	"flirts",
+	"floats",
+	"flocks",
+	"floods",
+	"floors",
+	"floppy",
+	"floral",
+	"flower",
+	"fluent",
+	"fluffy",
+	"fluids",
+	"fodder",
+	"fogged",
+	"foiled",
+	"folded",
+	"folder",
+	"fondly",
+	"fondue",
+	"fooled",
+	"footer",
+	"forage",
+	"forbid",
+	"forces",
+	"forged",
+	"forgot",
+	"formed",
+	"fossil",
+	"fouled",
+	"framed",
+	"frames",
+	"francs",
+	"frayed",
+	"freely",
+	"freeze",
+	"frenzy",
+	"fridge",
+	"frigid",
+	"frilly",
+	"fringe",
+	"frocks",
+	"frolic",
+	"fronts",
+	"frosty",
+	"fruits",
+	"frying",
+	"fueled",
+	"fumble",
+	"funded",
+	"fungus",
+	"funnel",
+	"futile",
+	"gadget",
+	"gained",
+	"galaxy",
+	"galley",
+	"gallon",
+	"gallop",
+	"galore",
+	"gamble",
+	"gaming",
+	"gander",
+	"gangly",
+	"gaping",
+	"garage",
+	"gargle",
+	"garlic",
+	"garnet",
+	"garter",
+	"gassed",
+	"gating",
+	"gauvas",
+	"gazebo",
+	"gazing",
+	"geared",
+	"gently",
+	"gerbil",
+	"getter",
+	"geyser",
+	"ghosts",
+	"ghouls",
+	"giblet",
+	"gifted",
+	"giggle",
+	"giggly",
+	"gigolo",
+	"gilled",
+	"ginger",
+	"girdle",
+	"glades",
+	"gladly",
+	"glance",
+	"glands",
+	"glazed",
+	"glider",
+	"glitch",
+	"glitzy",
+	"globes",
+	"gloomy",
+	"gloves",
+	"gluten",
+	"gnarly",
+	"gnawed",
+	"gnomes",
+	"gobble",
+	"goblin",
+	"gooder",
+	"gopher",
+	"gorged",
+	"gospel",
+	"gossip",
+	"gothic",
+	"gotten",
+	"gouged",
+	"govern",
+	"graces",
+	"graded",
+	"grader",
+	"grades",
+	"grains",
+	"gramps",
+	"granny",
+	"grants",
+	"grapes",
+	"grasps",
+	"grassy",
+	"gravel",
+	"graves",
+	"grazed",
+	"grease",
+	"greedy",
+	"greeks",
+	"grinch",
+	"gritty",
+	"groggy",
+	"groove",
+	"groovy",
+	"groups",
+	"grower",
+	"grubby",
+	"grudge",
+	"grumpy",
+	"grunge",
+	"guards",
+	"guests",
+	"guided",
+	"guides",
+	"guinea",
+	"guitar",
+	"gunned",
+	"gurgle",
+	"gutter",
+	"habits",
+	"hacked",
+	"hacker",
+	"haired",
+	"halved",
+	"halves",
+	"hamlet",
+	"hammer",
+	"hamper",
+	"hanged",
+	"hangup",
+	"hankie",
+	"harbor",
+	"harmed",
+	"hassle",
+	"hatbox",
+	"hating",
+	"hatred",
+	"haunts",
+	"having",
+	"hazard",
+	"hazily",
+	"hazing",
+	"header",
+	"healed",
+	"healer",
+	"hearts",
+	"heated",
+	"hedges",
+	"helium",
+	"helmet",
+	"helped",
+	"helper",
+	"herald",
+	"herbal",
+	"hereby",
+	"hermit",
+	"heroes",
+	"hiccup",
+	"hiding",
+	"higher",
+	"highly",
+	"hijack",
+	"hinges",
+	"hinted",
+	"hiring",
+	"hither",
+	"hockey",
+	"hollow",
+	"homing",
+	"honors",
+	"honour",
+	"hooked",
+	"hooves",
+	"hoping",
+	"hordes",
+	"hornet",
+	"horror",
+	"horses",
+	"hosted",
+	"hostel",
+	"hotels",
+	"hotter",
+	"housed",
+	"houses",
+	"hubcap",
+	"huddle",
+	"hugged",
+	"humans",
+	"humble",
+	"humbly",
+	"hummus",
+	"humped",
+	"humvee",
+	"hunger",
+	"hungry",
+	"hunted",
+	"hunter",
+	"hurdle",
+	"hurled",
+	"hurler",
+	"hurray",
+	"husked",
+	"hybrid",
+	"hyenas",
+	"hyphen",
+	"ideals",
+	"idiocy",
+	"idiots",
+	"ignore",
+	"iguana",
+	"images",
+	"immune",
+	"impale",
+	"impart",
+	"impish",
+	"impose",
+	"impure",
+	"inches",
+	"induce",
+	"inform",
+	"inject",
+	"inmate",
+	"insect",
+	"insert",
+	"insist",
+	"insure",
+	"intact",
+	"intern",
+	"invent",
+	"invite",
+	"invoke",
+	"inward",
+	"iodine",
+	"iodize",
+	"iphone",
+	"issued",
+	"issues",
+	"itches",
+	"itunes",
+	"jackal",
+	"jacket",
+	"jaguar",
+	"jailer",
+	"jammed",
+	"jargon",
+	"jazzed",
+	"jerked",
+	"jersey",
+	"jester",
+	"jigsaw",
+	"jingle",
+	"jinxed",
+	"jockey",
+	"jogger",
+	"joined",
+	"joints",
+	"joking",
+	"jovial",
+	"joyous",
+	"judged",
+	"judges",
+	"juggle",
+	"juiced",
+	"juicer",
+	"juices",
+	"jumble",
+	"jumped",
+	"jumper",
+	"jungle",
+	"junkie",
+	"juries",
+	"jurist",
+	"jurors",
+	"justly",
+	"karate",
+	"keenly",
+	"kegger",
+	"kennel",
+	"kernel",
+	"kettle",
+	"kicked",
+	"kidnap",
+	"kimono",
+	"kindle",
+	"kindly",
+	"kissed",
+	"kisser",
+	"kisses",
+	"kitten",
+	"kneels",
+	"knifes",
+	"knives",
+	"knocks",
+	"koalas",
+	"kosher",
+	"labels",
+	"lacked",
+	"ladder",
+	"ladies",
+	"lagged",
+	"lagoon",
+	"lament",
+	"landed",
+	"lapdog",
+	"lapped",
+	"lapses",
+	"laptop",
+	"larger",
+	"lasers",
+	"lashed",
+	"lashes",
+	"lasted",
+	"lately",
+	"latent",
+	"lather",
+	"lattes",
+	"laughs",
+	"laurel",
+	"lavish",
+	"lawful",
+	"layers",
+	"laying",
+	"layout",
+	"lazily",
+	"leaked",
+	"leaned",
+	"leaner",
+	"learns",
+	"learnt",
+	"leased",
+	"leaves",
+	"leeway",
+	"legend",
+	"legged",
+	"legume",
+	"lemons",
+	"lender",
+	"lenses",
+	"lesser",
+	"levels",
+	"levers",
+	"liable",
+	"licked",
+	"lifted",
+	"lifter",
+	"liking",
+	"lilies",
+	"limits",
+	"linear",
+	"linens",
+	"liners",
+	"lining",
+	"lipses",
+	"listed",
+	"liters",
+	"litmus",
+	"litter",
+	"lively",
+	"lizard",
+	"loaded",
+	"loaned",
+	"locals",
+	"locate",
+	"locked",
+	"locker",
+	"lodged",
+	"logged",
+	"longed",
+	"longer",
+	"looked",
+	"loosen",
+	"losers",
+	"losses",
+	"loudly",
+	"lounge",
+	"loving",
+	"lowers",
+	"lowest",
+	"lucked",
+	"lugged",
+	"lumber",
+	"lunacy",
+	"luring",
+	"lushly",
+	"luster",
+	"luxuty",
+	"lyrics",
+	"maggot",
+	"magnet",
+	"mailed",
+	"mailer",
+	"maimed",
+	"makers",
+	"mammal",
+	"manger",
+	"mangle",
+	"mangos",
+	"manila",
+	"manned",
+	"mantis",
+	"mantra",
+	"mapped",
+	"marble",
+	"marina",
+	"marlin",
+	"maroon",
+	"marrow",
+	"marshy",
+	"mascot",
+	"mashed",
+	"masked",
+	"masses",
+	"mating",
+	"matrix",
+	"matron",
+	"matted",
+	"maybes",
+	"mayday",
+	"meadow",
+	"meaner",
+	"medals",
+	"medics",
+	"mellow",
+	"melted",
+	"menace",
+	"mended",
+	"mentor",
+	"merits",
+	"messed",
+	"messes",
+	"metals",
+	"meteor",
+	"meters",
+	"mildly",
+	"minded",
+	"miners",
+	"mingle",
+	"minors",
+	"missed",
+	"misses",
+	"mitten",
+	"mixing",
+	"moaner",
+	"mocked",
+	"mocker",
+	"mockup",
+	"models",
+	"modify",
+	"module",
+	"months",
+	"mooing",
+	"mooned",
+	"moping",
+	"morale",
+	"morals",
+	"morons",
+	"mosaic",
+	"motels",
+	"motive",
+	"motors",
+	"mourns",
+	"mouths",
+	"movers",
+	"movies",
+	"mowing",
+	"muffin",
+	"mugged",
+	"mugger",
+	"mulled",
+	"mumble",
+	"muppet",
+	"muscle",
+	"musics",
+	"musket",
+	"muster",
+	"mutate",
+	"mutiny",
+	"muzzle",
+	"nachos",
+	"nailed",
+	"namely",
+	"naming",
+	"napkin",
+	"napped",
+	"nearer",
+	"neatly",
+	"nebula",
+	"nectar",
+	"needed",
+	"needle",
+	"negate",
+	"nephew",
+	"nerves",
+	"nestle",
+	"neuron",
+	"neuter",
+	"newest",
+	"nibble",
+	"nicely",
+	"nicest",
+	"nickel",
+	"nieces",
+	"nights",
+	"nimble",
+	"nimbly",
+	"noises",
+	"noodle",
+	"nosing",
+	"notify",
+	"noting",
+	"novels",
+	"novice",
+	"nozzle",
+	"nuance",
+	"nuclei",
+	"nugget",
+	"numbly",
+	"nursed",
+	"nurses",
+	"nutmeg",
+	"nuzzle",
+	"nymphs",
+	"oblige",
+	"oblong",
+	"obtuse",
+	"occult",
+	"occupy",
+	"occurs",
+	"oceans",
+	"ocelot",
+	"octane",
+	"octave",
+	"offend",
+	"offers",
+	"oldest",
+	"oldies",
+	"olives",
+	"onions",
+	"onward",
+	"oozing",
+	"opened",
+	"opener",
+	"openly",
+	"operas",
+	"oppose",
+	"orally",
+	"orchid",
+	"orders",
+	"organs",
+	"orient",
+	"orphan",
+	"others",
+	"ounces",
+	"outage",
+	"outbid",
+	"outfit",
+	"outing",
+	"outlet",
+	"outwit",
+	"overly",
+	"owners",
+	"owning",
+	"oxford",
+	"oxygen",
+	"oyster",
+	"pacify",
+	"pacing",
+	"packet",
+	"padded",
+	"paddle",
+	"paging",
+	"pagoda",
+	"paints",
+	"paired",
+	"paltry",
+	"panama",
+	"pandas",
+	"panels",
+	"panics",
+	"pantry",
+	"papaya",
+	"papers",
+	"parade",
+	"parcel",
+	"pardon",
+	"parish",
+	"parked",
+	"parlor",
+	"parole",
+	"parrot",
+	"parted",
+	"passed",
+	"passes",
+	"pastas",
+	"pasted",
+	"pastel",
+	"pastor",
+	"patchy",
+	"patrol",
+	"patter",
+	"pauper",
+	"pauses",
+	"paving",
+	"pawing",
+	"payday",
+	"paying",
+	"peaces",
+	"peaked",
+	"peanut",
+	"pebble",
+	"pebbly",
+	"pectin",
+	"peeked",
+	"peeled",
+	"peeved",
+	"pellet",
+	"pelvis",
+	"pencil",
+	"penpal",
+	"pepper",
+	"perish",
+	"pester",
+	"petals",
+	"petite",
+	"petted",
+	"phobia",
+	"phoned",
+	"phones",
+	"phoney",
+	"pianos",
+	"pickle",
+	"pieces",
+	"pierce",
+	"pigeon",
+	"piling",
+	"pillow",
+	"pining",
+	"pinned",
+	"piping",
+	"pirate",
+	"placed",
+	"places",
+	"plains",
+	"planes",
+	"plants",
+	"plaque",
+	"plasma",
+	"plated",
+	"plates",
+	"played",
+	"pledge",
+	"pliers",
+	"plowed",
+	"plunge",
+	"plural",
+	"poetic",
+	"points",
+	"pointy",
+	"poised",
+	"poison",
+	"poking",
+	"polish",
+	"polite",
+	"poncho",
+	"ponies",
+	"poorer",
+	"poorly",
+	"poplar",
+	"popped",
+	"popper",
+	"porous",
+	"portal",
+	"portly",
+	"posing",
+	"posses",
+	"possum",
+	"postal",
+	"posted",
+	"poster",
+	"potato",
+	"potent",
+	"pounce",
+	"pounds",
+	"poured",
+	"powder",
+	"powwow",
+	"praise",
+	"prance",
+	"pranks",
+	"prayed",
+	"prayer",
+	"precut",
+	"preens",
+	"prefix",
+	"prelaw",
+	"prepay",
+	"preppy",
+	"preset",
+	"prewar",
+	"priced",
+	"prices",
+	"pricks",
+	"primal",
+	"primed",
+	"primer",
+	"prints",
+	"priors",
+	"prissy",
+	"prized",
+	"prizes",
+	"promos",
+	"prompt",
+	"pronto",
+	"proofs",
+	"proton",
+	"proved",
+	"proves",
+	"prozac",
+	"prunes",
+	"prying",
+	"pucker",
+	"puddle",
+	"pueblo",
+	"puffed",
+	"puffin",
+	"pulled",
+	"pumice",
+	"pummel",
+	"pumped",
+	"pupils",
+	"puppet",
+	"purely",
+	"purest",
+	"purify",
+	"purist",
+	"purity",
+	"purple",
+	"purses",
+	"pushed",
+	"pusher",
+	"pushes",
+	"pushup",
+	"puzzle",
+	"python",
+	"quarry",
+	"quartz",
+	"queers",
+	"quench",
+	"quirks",
+	"quiver",
+	"quoted",
+	"quotes",
+	"rabbit",
+	"racing",
+	"racism",
+	"racked",
+	"racket",
+	"racoon",
+	"radial",
+	"radios",
+	"radish",
+	"radius",
+	"raffle",
+	"ragged",
+	"raging",
+	"raided",
+	"raider",
+	"rained",
+	"raiser",
+	"raises",
+	"raisin",
+	"raking",
+	"ramble",
+	"ramrod",
+	"ranged",
+	"ranger",
+	"ranges",
+	"ranked",
+	"ransom",
+	"rapids",
+	"rarity",
+	"rascal",
+	"rattle",
+	"ravage",
+	"ravine",
+	"raving",
+	"razors",
+	"reacts",
+	"reared",
+	"rebate",
+	"reboot",
+	"reborn",
+	"rebuff",
+	"recant",
+	"recast",
+	"recede",
+	"recess",
+	"recipe",
+	"recite",
+	"reckon",
+	"recoil",
+	"recopy",
+	"recoup",
+	"rectal",
+	"refers",
+	"refill",
+	"reflex",
+	"reflux",
+	"refold",
+	"refund",
+	"refuse",
+	"refute",
+	"regain",
+	"reggae",
+	"rehash",
+	"reheat",
+	"rehire",
+	"reject",
+	"rejoin",
+	"relent",
+	"relied",
+	"relies",
+	"relish",
+	"relive",
+	"reload",
+	"relock",
+	"remake",
+	"remark",
+	"remedy",
+	"remind",
+	"remold",
+	"rename",
+	"rental",
+	"rented",
+	"renter",
+	"reopen",
+	"repave",
+	"repeal",
+	"repels",
+	"repent",
+	"repose",
+	"repost",
+	"reruns",
+	"resale",
+	"reseal",
+	"resend",
+	"resent",
+	"reside",
+	"resign",
+	"resist",
+	"resize",
+	"rested",
+	"resume",
+	"retake",
+	"retard",
+	"retire",
+	"retold",
+	"retool",
+	"retype",
+	"reverb",
+	"revert",
+	"revise",
+	"revoke",
+	"revolt",
+	"rewash",
+	"rewind",
+	"rewire",
+	"reword",
+	"rework",
+	"rewrap",
+	"rhythm",
+	"ribbon",
+	"ribses",
+	"richer",
+	"riches",
+	"richly",
+	"ridden",
+	"riddle",
+	"rifles",
+	"rights",
+	"rimmed",
+	"ripple",
+	"risked",
+	"rivals",
+	"rivers",
+	"roamer",
+	"rocked",
+	"rocker",
+	"rocket",
+	"rodent",
+	"rolled",
+	"rooted",
+	"roping",
+	"roster",
+	"rotted",
+	"rotten",
+	"routed",
+	"routes",
+	"roving",
+	"rubbed",
+	"rubber",
+	"rubble",
+	"rubies",
+	"ruckus",
+	"rudder",
+	"rugged",
+	"ruined",
+	"rumble",
+	"rumors",
+	"runner",
+	"runway",
+	"rushed",
+	"rushes",
+	"rusted",
+	"sacked",
+	"sacred",
+	"sadden",
+	"saddle",
+	"safari",
+	"safely",
+	"safest",
+	"sailed",
+	"salads",
+	"salami",
+	"saline",
+	"salmon",
+	"saloon",
+	"salute",
+	"sandal",
+	"sanded",
+	"saucer",
+	"savage",
+	"savior",
+	"scabby",
+	"scales",
+	"scarce",
+	"scared",
+	"scares",
+	"scenes",
+	"scenic",
+	"scolds",
+	"scones",
+	"scoops",
+	"scorch",
+	"scored",
+	"scorer",
+	"scores",
+	"scotch",
+	"scouts",
+	"scrape",
+	"scraps",
+	"scream",
+	"screws",
+	"scribe",
+	"scroll",
+	"scrubs",
+	"scurvy",
+	"sealed",
+	"seated",
+	"sedate",
+	"sedges",
+	"seduce",
+	"seemed",
+	"segues",
+	"seized",
+	"seldom",
+	"senate",
+	"sender",
+	"sensed",
+	"senses",
+	"septic",
+	"septum",
+	"sequel",
+	"serial",
+	"sermon",
+	"served",
+	"serves",
+	"sesame",
+	"sewers",
+	"sewing",
+	"shabby",
+	"shaded",
+	"shades",
+	"shakes",
+	"shamed",
+	"shanty",
+	"shaped",
+	"shapes",
+	"shards",
+	"shared",
+	"shares",
+	"sharks",
+	"sheafs",
+	"sheath",
+	"shelve",
+	"sherry",
+	"shield",
+	"shifts",
+	"shifty",
+	"shimmy",
+	"shined",
+	"shines",
+	"shirts",
+	"shiver",
+	"shocks",
+	"shoots",
+	"shorts",
+	"shorty",
+	"shouts",
+	"shoved",
+	"showed",
+	"shower",
+	"shrank",
+	"shreds",
+	"shriek",
+	"shrill",
+	"shrimp",
+	"shrine",
+	"shrink",
+	"shrubs",
+	"shrugs",
+	"shrunk",
+	"shucks",
+	"sicker",
+	"sickle",
+	"sickly",
+	"siding",
+	"sierra",
+	"siesta",
+	"sights",
+	"signed",
+	"silica",
+	"simile",
+	"singer",
+	"sinker",
+	"sinner",
+	"sirens",
+	"sitcom",
+	"sitter",
+	"sizing",
+	"sizzle",
+	"skater",
+	"skates",
+	"sketch",
+	"skewed",
+	"skewer",
+	"skiing",
+	"skills",
+	"skinny",
+	"skirts",
+	"skulls",
+	"slacks",
+	"slaves",
+	"sleeps",
+	"sleeve",
+	"sliced",
+	"slicer",
+	"slices",
+	"slider",
+	"slides",
+	"slinky",
+	"sliver",
+	"slogan",
+	"sloped",
+	"slopes",
+	"sloppy",
+	"slowed",
+	"slower",
+	"slowly",
+	"sludge",
+	"smacks",
+	"smarts",
+	"smarty",
+	"smells",
+	"smiled",
+	"smiles",
+	"smoked",
+	"smokes",
+	"smudge",
+	"smudgy",
+	"smugly",
+	"snails",
+	"snarls",
+	"snazzy",
+	"sneaks",
+	"sneeze",
+	"snitch",
+	"snooze",
+	"snores",
+	"snowed",
+	"snugly",
+	"soaked",
+	"socket",
+	"soften",
+	"softer",
+	"softly",
+	"soiled",
+	"solved",
+	"solves",
+	"sooner",
+	"sorely",
+	"sorrow",
+	"sorted",
+	"sounds",
+	"spaced",
+	"spaces",
+	"spared",
+	"spasms",
+	"speaks",
+	"specks",
+	"speeds",
+	"spells",
+	"spends",
+	"sphere",
+	"sphinx",
+	"spices",
+	"spider",
+	"spiffy",
+	"spiked",
+	"spills",
+	"spinal",
+	"spiral",
+	"splash",
+	"spleen",
+	"splice",
+	"splits",
+	"spoils",
+	"sponge",
+	"spongy",
+	"spooks",
+	"spooky",
+	"spoons",
+	"sports",
+	"sporty",
+	"spotty",
+	"spouse",
+	"sprain",
+	"sprang",
+	"sprawl",
+	"sprint",
+	"sprite",
+	"sprout",
+	"spruce",
+	"sprung",
+	"squads",
+	"squall",
+	"squash",
+	"squeak",
+	"squint",
+	"squire",
+	"squirt",
+	"stacks",
+	"staffs",
+	"staged",
+	"stages",
+	"stains",
+	"stairs",
+	"staked",
+	"stakes",
+	"stalls",
+	"stance",
+	"stands",
+	"staple",
+	"starch",
+	"stared",
+	"stares",
+	"starry",
+	"starts",
+	"starve",
+	"stated",
+	"states",
+	"static",
+	"statue",
+	"stayed",
+	"steaks",
+	"steals",
+	"stench",
+	"stereo",
+	"stewed",
+	"sticky",
+	"stiffs",
+	"stifle",
+	"stilts",
+	"stings",
+	"stingy",
+	"stinks",
+	"stinky",
+	"stitch",
+	"stoked",
+	"stooge",
+	"stored",
+	"stores",
+	"stoves",
+	"strand",
+	"straps",
+	"straws",
+	"strays",
+	"streak",
+	"strewn",
+	"stride",
+	"strife",
+	"strips",
+	"strive",
+	"strobe",
+	"strode",
+	"strung",
+	"stucco",
+	"stuffy",
+	"stumps",
+	"stunts",
+	"stupor",
+	"sturdy",
+	"stylus",
+	"sublet",
+	"subpar",
+	"subtle",
+	"subtly",
+	"suburb",
+	"subway",
+	"sudoku",
+	"suffix",
+	"suited",
+	"suites",
+	"suitor",
+	"sulfur",
+	"sullen",
+	"sultry",
+	"sundae",
+	"superb",
+	"supper",
+	"surfer",
+	"swayed",
+	"swears",
+	"sweats",
+	"sweeps",
+	"swerve",
+	"swings",
+	"swiped",
+	"swivel",
+	"swoops",
+	"swoosh",
+	"tables",
+	"tablet",
+	"tacked",
+	"tackle",
+	"tagged",
+	"tailed",
+	"tailor",
+	"takers",
+	"talcum",
+	"talked",
+	"talker",
+	"taller",
+	"tamale",
+	"tamper",
+	"tangle",
+	"tanked",
+	"tanned",
+	"taping",
+	"tarmac",
+	"tartar",
+	"tartly",
+	"tassel",
+	"tasted",
+	"tastes",
+	"tattle",
+	"tattoo",
+	"tavern",
+	"taxing",
+	"teamed",
+	"teapot",
+	"teased",
+	"temple",
+	"tended",
+	"tested",
+	"thawed",
+	"theirs",
+	"themes",
+	"theres",
+	"thesis",
+	"things",
+	"thingy",
+	"thinks",
+	"thinly",
+	"thirds",
+	"thorns",
+	"thorny",
+	"thrash",
+	"thread",
+	"threes",
+	"thrift",
+	"thrill",
+	"thrive",
+	"throat",
+	"throne",
+	"throng",
+	"throws",
+	"ticked",
+	"ticker",
+	"tickle",
+	"tidbit",
+	"tigers",
+	"tiling",
+	"timely",
+	"timers",
+	"tingle",
+	"tingly",
+	"tinker",
+	"tinsel",
+	"tipoff",
+	"tipped",
+	"tipper",
+	"tiptop",
+	"tiring",
+	"titled",
+	"titles",
+	"toasts",
+	"toeses",
+	"toggle",
+	"toilet",
+	"tomato",
+	"tongue",
+	"topics",
+	"tossed",
+	"tosses",
+	"toured",
+	"towels",
+	"toxins",
+	"toying",
+	"traced",
+	"traces",
+	"tracks",
+	"traded",
+	"trades",
+	"trails",
+	"trains",
+	"traits",
+	"trance",
+	"treats",
+	"treble",
+	"tremor",
+	"trench",
+	"trends",
+	"triage",
+	"trials",
+	"tribes",
+	"tricks",
+	"tricky",
+	"trifle",
+	"triple",
+	"tripod",
+	"trolls",
+	"troops",
+	"trophy",
+	"trough",
+	"troupe",
+	"trowel",
+	"trucks",
+	"truest",
+	"trunks",
+	"trusts",
+	"truths",
+	"tucked",
+	"tugger",
+	"tumble",
+	"tumors",
+	"tuning",
+	"tunnel",
+	"turban",
+	"turkey",
+	"turned",
+	"turret",
+	"turtle",
+	"tuxedo",
+	"tweaks",
+	"twists",
+	"twisty",
+	"twitch",
+	"tycoon",
+	"typing",
+	"ulcers",
+	"umpire",
+	"unbend",
+	"unbent",
+	"unborn",
+	"unclad",
+	"uncles",
+	"unclip",
+	"unclog",
+	"uncork",
+	"undead",
+	"undone",
+	"unease",
+	"uneasy",
+	"uneven",
+	"unfair",
+	"unfold",
+	"unglue",
+	"unholy",
+	"unhook",
+	"unions",
+	"unison",
+	"unkind",
+	"unload",
+	"unlock",
+	"unmade",
+	"unpack",
+	"unpaid",
+	"unplug",
+	"unread",
+	"unreal",
+	"unrest",
+	"unripe",
+	"unroll",
+	"unruly",
+	"unsafe",
+	"unsaid",
+	"unseen",
+	"unsent",
+	"unsnap",
+	"unsold",
+	"unsure",
+	"untidy",
+	"untied",
+	"unties",
+	"untold",
+	"untrue",
+	"unused",
+	"unveil",
+	"unwary",
+	"unwell",
+	"unwind",
+	"unwise",
+	"unworn",
+	"unwrap",
+	"upbeat",
+	"upheld",
+	"uphill",
+	"uphold",
+	"upload",
+	"uproar",
+	"uproot",
+	"upsets",
+	"upside",
+	"uptake",
+	"uptown",
+	"upward",
+	"upwind",
+	"urchin",
+	"urgent",
+	"urging",
+	"usable",
+	"ushers",
+	"utmost",
+	"utopia",
+	"vacant",
+	"vacate",
+	"vacuum",
+	"valium",
+	"valued",
+	"values",
+	"valves",
+	"vanish",
+	"vanity",
+	"vastly",
+	"veggie",
+	"veiled",
+	"velcro",
+	"velvet",
+	"verbal",
+	"verger",
+	"verify",
+	"versed",
+	"verses",
+	"vessel",
+	"vested",
+	"viable",
+	"videos",
+	"viewed",
+	"viewer",
+	"vilify",
+	"villas",
+	"violet",
+	"violin",
+	"virtue",
+	"visits",
+	"vitals",
+	"voices",
+	"volley",
+	"vomits",
+	"vortex",
+	"voters",
+	"voting",
+	"voyage",
+	"wacked",
+	"wading",
+	"waffle",
+	"waggle",
+	"waging",
+	"wagons",
+	"waists",
+	"waited",
+	"waiter",
+	"waived",
+	"waking",
+	"walked",
+	"wallet",
+	"walnut",
+	"walrus",
+	"wander",
+	"wanted",
+	"warmed",
+	"warmer",
+	"warmth",
+	"warned",
+	"wasabi",
+	"washed",
+	"washer",
+	"washes",
+	"wasted",
+	"waters",
+	"waving",
+	"weaker",
+	"weasel",
+	"wedged",
+	"weighs",
+	"welles",
+	"whacky",
+	"whales",
+	"whence",
+	"whilst",
+	"whinny",
+	"whirls",
+	"whisky",
+	"whiter",
+	"whoops",
+	"whores",
+	"wicked",
+	"widely",
+	"widens",
+	"widget",
+	"widows",
+	"wiggle",
+	"wilder",
+	"wildly",
+	"willed",
+	"willow",
+	"winded",
+	"winged",
+	"wiping",
+	"wiring",
+	"wisdom",
+	"wisely",
+	"wisest",
+	"wished",
+	"wishes",
+	"wither",
+	"witted",
+	"witter",
+	"wizard",
+	"wobble",
+	"wobbly",
+	"wombat",
+	"wooing",
+	"worked",
+	"worlds",
+	"wounds",
+	"wracks",
+	"wreath",
+	"wrecks",
+	"wrench",
+	"wrists",
+	"writes",
+	"wrongs",
+	"yachts",
+	"yanked",
+	"yearly",
+	"yelled",
+	"yippee",
+	"yogurt",
+	"yonder",
+	"zapped",
+	"zealot",
+	"zebras",
+	"zenith",
+	"zeroes",
+	"zigzag",
+	"zipped",
+	"zipper",
+	"zodiac",
+	"zombie",
+	"zoning",
+}
@@ -0,0 +1,3527 @@
+package wordle
+
+// answers for 7 letter games, in the order daily puzzles use them
+var words7 = []string{
+	"telling",
+	"passage",
+	"analyst",
+	"wedding",
+	"sustain",
+	"require",
+	"monthly",
+	"premium",
+	"default",
+	"enhance",
+	"receive",
+	"college",
+	"monitor",
+	"ability",
+	"fifteen",
+	"quality",
+	"liberty",
+	"western",
+	"adviser",
+	"removed",
+	"pattern",
+	"penalty",
+	"outdoor",
+	"freedom",
+	"example",
+	"through",
+	"contest",
+	"exhibit",
+	"current",
+	"chicken",
+	"nothing",
+	"alcohol",
+	"weekend",
+	"opening",
+	"capital",
+	"evident",
+	"retired",
+	"advance",
+	"journal",
+	"contain",
+	"discuss",
+	"exactly",
+	"picking",
+	"victory",
+	"release",
+	"account",
+	"satisfy",
+	"forward",
+	"finding",
+	"combine",
+	"several",
+	"website",
+	"attempt",
+	"learned",
+	"therapy",
+	"webcast",
+	"country",
+	"printer",
+	"elderly",
+	"wearing",
+	"genuine",
+	"consent",
+	"healthy",
+	"trouble",
+	"decided",
+	"clothes",
+	"upgrade",
+	"drawing",
+	"classic",
+	"radical",
+	"convert",
+	"address",
+	"economy",
+	"comfort",
+	"subject",
+	"crystal",
+	"airline",
+	"teacher",
+	"jointly",
+	"winning",
+	"outlook",
+	"portion",
+	"silence",
+	"touched",
+	"nervous",
+	"witness",
+	"society",
+	"unusual",
+	"compare",
+	"protest",
+	"feature",
+	"herself",
+	"alleged",
+	"meeting",
+	"collect",
+	"setting",
+	"ancient",
+	"factory",
+	"counter",
+	"session",
+	"surplus",
+	"library",
+	"feeling",
+	"natural",
+	"pension",
+	"certain",
+	"channel",
+	"weather",
+	"turning",
+	"respect",
+	"dispute",
+	"whether",
+	"destroy",
+	"species",
+	"correct",
+	"disease",
+	"primary",
+	"gallery",
+	"hanging",
+	"written",
+	"connect",
+	"decline",
+	"somehow",
+	"already",
+	"nursing",
+	"skilled",
+	"charity",
+	"helpful",
+	"strange",
+	"imaging",
+	"lasting",
+	"pending",
+	"mistake",
+	"passive",
+	"sixteen",
+	"capable",
+	"heavily",
+	"finance",
+	"replace",
+	"publish",
+	"suspect",
+	"academy",
+	"science",
+	"regular",
+	"respond",
+	"eastern",
+	"rollout",
+	"illegal",
+	"density",
+	"listing",
+	"plastic",
+	"examine",
+	"beating",
+	"warrant",
+	"surface",
+	"minimal",
+	"bedroom",
+	"filling",
+	"imagine",
+	"pointed",
+	"context",
+	"anybody",
+	"process",
+	"instead",
+	"protect",
+	"between",
+	"message",
+	"storage",
+	"content",
+	"protein",
+	"fiction",
+	"anxious",
+	"qualify",
+	"payment",
+	"federal",
+	"deposit",
+	"prevent",
+	"virtual",
+	"minimum",
+	"walking",
+	"mixture",
+	"someone",
+	"burning",
+	"justice",
+	"product",
+	"ongoing",
+	"reverse",
+	"running",
+	"proceed",
+	"complex",
+	"station",
+	"veteran",
+	"compete",
+	"exclude",
+	"similar",
+	"include",
+	"kitchen",
+	"deliver",
+	"checked",
+	"vehicle",
+	"acquire",
+	"welfare",
+	"justify",
+	"surgery",
+	"kingdom",
+	"numeral",
+	"capture",
+	"telecom",
+	"payable",
+	"control",
+	"illness",
+	"caution",
+	"hundred",
+	"survive",
+	"liberal",
+	"realize",
+	"husband",
+	"expense",
+	"optical",
+	"faculty",
+	"service",
+	"diamond",
+	"highway",
+	"removal",
+	"divided",
+	"caption",
+	"railway",
+	"concern",
+	"venture",
+	"perhaps",
+	"maximum",
+	"promise",
+	"insight",
+	"formula",
+	"unknown",
+	"interim",
+	"failure",
+	"thereby",
+	"revenue",
+	"premier",
+	"support",
+	"largely",
+	"dynamic",
+	"foreign",
+	"seventh",
+	"special",
+	"mention",
+	"picture",
+	"limited",
+	"million",
+	"accused",
+	"display",
+	"produce",
+	"neither",
+	"prepare",
+	"passion",
+	"diverse",
+	"segment",
+	"visible",
+	"towards",
+	"benefit",
+	"express",
+	"machine",
+	"logical",
+	"stretch",
+	"married",
+	"provide",
+	"ceiling",
+	"remains",
+	"nuclear",
+	"quarter",
+	"digital",
+	"climate",
+	"heading",
+	"village",
+	"battery",
+	"airport",
+	"general",
+	"cabinet",
+	"whereas",
+	"popular",
+	"leaving",
+	"tension",
+	"article",
+	"restore",
+	"captain",
+	"command",
+	"journey",
+	"counsel",
+	"banking",
+	"contact",
+	"reflect",
+	"without",
+	"loyalty",
+	"calling",
+	"history",
+	"housing",
+	"program",
+	"profile",
+	"thought",
+	"request",
+	"assault",
+	"mission",
+	"century",
+	"failing",
+	"viewing",
+	"gigabit",
+	"neutral",
+	"serving",
+	"circuit",
+	"absence",
+	"element",
+	"distant",
+	"confirm",
+	"passing",
+	"manager",
+	"achieve",
+	"deficit",
+	"predict",
+	"version",
+	"concept",
+	"beneath",
+	"section",
+	"defence",
+	"hearing",
+	"further",
+	"improve",
+	"recover",
+	"private",
+	"intense",
+	"charter",
+	"edition",
+	"offense",
+	"however",
+	"showing",
+	"compact",
+	"smoking",
+	"reserve",
+	"himself",
+	"upscale",
+	"develop",
+	"chamber",
+	"shortly",
+	"culture",
+	"suppose",
+	"meaning",
+	"careful",
+	"perfect",
+	"company",
+	"founder",
+	"leading",
+	"against",
+	"forever",
+	"routine",
+	"helping",
+	"engaged",
+	"keeping",
+	"success",
+	"variety",
+	"privacy",
+	"explore",
+	"receipt",
+	"various",
+	"balance",
+	"project",
+	"reading",
+	"license",
+	"driving",
+	"binding",
+	"wanting",
+	"landing",
+	"painted",
+	"suggest",
+	"patient",
+	"fortune",
+	"readily",
+	"fishing",
+	"student",
+	"overall",
+	"uniform",
+	"because",
+	"promote",
+	"anxiety",
+	"inquiry",
+	"massive",
+	"writing",
+	"studied",
+	"serious",
+	"comment",
+	"traffic",
+	"missing",
+	"totally",
+	"willing",
+	"percent",
+	"essence",
+	"killing",
+	"resolve",
+	"fitness",
+	"measure",
+	"morning",
+	"sponsor",
+	"pacific",
+	"believe",
+	"despite",
+	"sitting",
+	"reality",
+	"theatre",
+	"speaker",
+	"devoted",
+	"excited",
+	"gateway",
+	"knowing",
+	"utility",
+	"leisure",
+	"extreme",
+	"silicon",
+	"instant",
+	"network",
+	"purpose",
+	"medical",
+	"council",
+	"pioneer",
+	"violent",
+	"organic",
+	"backing",
+	"related",
+	"obvious",
+	"brought",
+	"greater",
+	"consist",
+	"present",
+	"typical",
+	"parking",
+	"caliber",
+	"conduct",
+	"pushing",
+	"succeed",
+	"opinion",
+	"explain",
+	"outside",
+	"carrier",
+	"nowhere",
+	"chronic",
+	"warning",
+	"evening",
+	"chapter",
+	"tonight",
+	"operate",
+	"applied",
+	"cutting",
+	"arrival",
+	"holiday",
+	"partner",
+	"package",
+	"average",
+	"welcome",
+	"poverty",
+	"precise",
+	"dealing",
+	"central",
+	"outcome",
+	"supreme",
+	"auction",
+	"install",
+	"officer",
+	"billion",
+	"desktop",
+	"barrier",
+	"initial",
+	"involve",
+	"besides",
+	"another",
+	"crucial",
+	"mineral",
+	"waiting",
+	"attract",
+	"partial",
+	"genetic",
+	"brother",
+	"notable",
+	"summary",
+	"bearing",
+	"arrange",
+	"problem",
+	"holding",
+	"closing",
+	"working",
+	"fashion",
+}
+
+// words accepted as 7 letter guesses on top of the answers, from the
+// sources listed in lists.go
+var extraWords7 = []string{
+	"abandon",
+	"abdomen",
+	"abiding",
+	"abolish",
+	"aborted",
+	"abreast",
+	"abridge",
+	"absolve",
+	"absorbs",
+	"abstain",
+	"abusers",
+	"abusing",
+	"accents",
+	"accepts",
+	"acclaim",
+	"acetone",
+	"acrobat",
+	"acronym",
+	"actions",
+	"actress",
+	"acutely",
+	"adamant",
+	"adapter",
+	"admired",
+	"admirer",
+	"admires",
+	"adopted",
+	"adoring",
+	"adorned",
+	"adverse",
+	"advised",
+	"aerials",
+	"aerobic",
+	"aerosol",
+	"affairs",
+	"affects",
+	"affront",
+	"ageless",
+	"agendas",
+	"agility",
+	"agonize",
+	"aground",
+	"alarmed",
+	"alchemy",
+	"alerted",
+	"alfalfa",
+	"algebra",
+	"aliases",
+	"aligned",
+	"allowed",
+	"almanac",
+	"alright",
+	"altered",
+	"amateur",
+	"amazing",
+	"ambient",
+	"amended",
+	"amenity",
+	"amiable",
+	"ammonia",
+	"amnesia",
+	"amnesty",
+	"amongst",
+	"amounts",
+	"amplify",
+	"amusing",
+	"anagram",
+	"analogy",
+	"analyze",
+	"anatomy",
+	"anchors",
+	"anchovy",
+	"android",
+	"angelic",
+	"angling",
+	"angrily",
+	"angular",
+	"animals",
+	"animate",
+	"annoyed",
+	"annuity",
+	"anoints",
+	"answers",
+	"antacid",
+	"antenna",
+	"anthill",
+	"antlers",
+	"antonym",
+	"anymore",
+	"anyones",
+	"anytime",
+	"anyways",
+	"apostle",
+	"appeals",
+	"appears",
+	"appease",
+	"applaud",
+	"applies",
+	"approve",
+	"apricot",
+	"aquatic",
+	"archive",
+	"arguing",
+	"armband",
+	"armhole",
+	"armless",
+	"armoire",
+	"armored",
+	"armrest",
+	"arousal",
+	"arrests",
+	"arrived",
+	"arrives",
+	"arsenal",
+	"artists",
+	"artwork",
+	"ashamed",
+	"aspects",
+	"aspirin",
+	"assists",
+	"assumed",
+	"assumes",
+	"assured",
+	"assures",
+	"astound",
+	"astride",
+	"atheist",
+	"atrophy",
+	"attacks",
+	"audible",
+	"audibly",
+	"audited",
+	"auditor",
+	"authors",
+	"autopsy",
+	"avatars",
+	"avenged",
+	"averted",
+	"aviator",
+	"avocado",
+	"avoided",
+	"awarded",
+	"awfully",
+	"awkward",
+	"backers",
+	"backlit",
+	"backlog",
+	"backups",
+	"badland",
+	"badness",
+	"baffled",
+	"baggage",
+	"bagging",
+	"bagpipe",
+	"bailing",
+	"baiting",
+	"balcony",
+	"balding",
+	"ballast",
+	"balloon",
+	"ballots",
+	"bananas",
+	"bandage",
+	"bandits",
+	"bangers",
+	"banging",
+	"bankers",
+	"banners",
+	"banquet",
+	"banshee",
+	"barbell",
+	"barcode",
+	"bargain",
+	"barging",
+	"barista",
+	"barking",
+	"barmaid",
+	"baronet",
+	"barrack",
+	"barrels",
+	"barring",
+	"bashing",
+	"baskets",
+	"batches",
+	"batting",
+	"battles",
+	"bayonet",
+	"bazooka",
+	"beaches",
+	"beaming",
+	"beanses",
+	"bearded",
+	"beastly",
+	"becomes",
+	"bedding",
+	"bedrock",
+	"beehive",
+	"beeping",
+	"behaved",
+	"behaves",
+	"belated",
+	"beliefs",
+	"bellied",
+	"bellies",
+	"belongs",
+	"bending",
+	"berries",
+	"bestest",
+	"betrays",
+	"biggest",
+	"bigoted",
+	"bikinis",
+	"billing",
+	"bitched",
+	"bitches",
+	"blabber",
+	"blacked",
+	"bladder",
+	"blaming",
+	"blanket",
+	"blaring",
+	"blasted",
+	"blazers",
+	"blazing",
+	"bleeder",
+	"blemish",
+	"blended",
+	"blender",
+	"blesses",
+	"blinded",
+	"blindly",
+	"blinked",
+	"blinker",
+	"blister",
+	"bloated",
+	"blocked",
+	"blogger",
+	"blooded",
+	"blooper",
+	"blossom",
+	"blouses",
+	"blowing",
+	"blubber",
+	"blurred",
+	"blurted",
+	"boarded",
+	"boaster",
+	"bobbing",
+	"bobsled",
+	"bobtail",
+	"boiling",
+	"bolster",
+	"bombers",
+	"bombing",
+	"bonanza",
+	"bonding",
+	"boneses",
+	"bonfire",
+	"bonuses",
+	"booking",
+	"booming",
+	"boosted",
+	"booties",
+	"booting",
+	"bootleg",
+	"bootses",
+	"boozing",
+	"borough",
+	"bossing",
+	"botched",
+	"bothers",
+	"bottled",
+	"bottles",
+	"bounced",
+	"bounces",
+	"bouquet",
+	"boxlike",
+	"bragged",
+	"brained",
+	"brainer",
+	"branded",
+	"brasses",
+	"bravado",
+	"bravely",
+	"bravery",
+	"bravest",
+	"breaths",
+	"breeder",
+	"breezes",
+	"brewers",
+	"brewery",
+	"brewing",
+	"bribing",
+	"bridged",
+	"bridges",
+	"briefed",
+	"briefly",
+	"brigade",
+	"brisket",
+	"briskly",
+	"bristle",
+	"brittle",
+	"broaden",
+	"broader",
+	"broadly",
+	"broiler",
+	"brokers",
+	"broncos",
+	"brushed",
+	"brushes",
+	"bubbles",
+	"buckets",
+	"bucking",
+	"buckled",
+	"buckles",
+	"buddies",
+	"budding",
+	"budgets",
+	"buffalo",
+	"buffing",
+	"buffoon",
+	"bulging",
+	"bulldog",
+	"bullets",
+	"bullied",
+	"bullies",
+	"bullion",
+	"bullish",
+	"bullpen",
+	"bumping",
+	"bunches",
+	"bundles",
+	"bunkbed",
+	"burdens",
+	"burgers",
+	"burglar",
+	"burping",
+	"burying",
+	"busboys",
+	"busload",
+	"busting",
+	"butcher",
+	"butters",
+	"butting",
+	"buzzing",
+	"cabbage",
+	"caboose",
+	"cackles",
+	"cadmium",
+	"cahoots",
+	"calcium",
+	"callers",
+	"calming",
+	"caloric",
+	"calorie",
+	"calzone",
+	"cameras",
+	"campers",
+	"camping",
+	"cancels",
+	"cancers",
+	"candied",
+	"candies",
+	"candles",
+	"canning",
+	"cannons",
+	"canteen",
+	"capably",
+	"capitol",
+	"capsize",
+	"capsule",
+	"captive",
+	"caramel",
+	"caravan",
+	"cardiac",
+	"careers",
+	"carless",
+	"carload",
+	"carnage",
+	"carpets",
+	"carpool",
+	"carport",
+	"carried",
+	"carries",
+	"carrots",
+	"cartels",
+	"cartons",
+	"cartoon",
+	"carvers",
+	"carving",
+	"carwash",
+	"cascade",
+	"cashier",
+	"cashing",
+	"casings",
+	"casinos",
+	"caskets",
+	"casting",
+	"castles",
+	"catalog",
+	"catcall",
+	"catcher",
+	"catches",
+	"catered",
+	"caterer",
+	"catfish",
+	"catlike",
+	"cattail",
+	"catwalk",
+	"causing",
+	"cavalry",
+	"celtics",
+	"centers",
+	"centres",
+	"certify",
+	"chafing",
+	"chained",
+	"chalice",
+	"chances",
+	"changed",
+	"changes",
+	"chaotic",
+	"chapped",
+	"charged",
+	"charger",
+	"charges",
+	"chariot",
+	"charmed",
+	"charmer",
+	"charred",
+	"chasing",
+	"chatted",
+	"chatter",
+	"cheaper",
+	"cheated",
+	"checker",
+	"cheddar",
+	"cheered",
+	"cheeses",
+	"cheetah",
+	"chemist",
+	"chevron",
+	"chewing",
+	"chilled",
+	"chimney",
+	"chipped",
+	"choices",
+	"chokers",
+	"choking",
+	"chooser",
+	"chooses",
+	"chopped",
+	"chorale",
+	"chowder",
+	"cinemas",
+	"circled",
+	"circles",
+	"citable",
+	"citadel",
+	"citizen",
+	"claimed",
+	"clapped",
+	"clapper",
+	"clarify",
+	"clarity",
+	"classes",
+	"clatter",
+	"clauses",
+	"clawing",
+	"cleaned",
+	"cleanup",
+	"cleared",
+	"clearer",
+	"clearly",
+	"cleaver",
+	"clicked",
+	"clicker",
+	"clients",
+	"climbed",
+	"clinics",
+	"clipped",
+	"clobber",
+	"clocked",
+	"clogged",
+	"cloning",
+	"closely",
+	"closest",
+	"closets",
+	"closure",
+	"clothed",
+	"clouded",
+	"clubbed",
+	"cluster",
+	"clutter",
+	"coached",
+	"coaches",
+	"coastal",
+	"coaster",
+	"coating",
+	"cobbler",
+	"cobwebs",
+	"cockpit",
+	"coconut",
+	"coerced",
+	"coexist",
+	"coffees",
+	"coffins",
+	"coldest",
+	"collage",
+	"collars",
+	"collide",
+	"colored",
+	"columns",
+	"combing",
+	"commend",
+	"commode",
+	"commute",
+	"compile",
+	"compose",
+	"compost",
+	"comrade",
+	"concave",
+	"conceal",
+	"concert",
+	"concise",
+	"condone",
+	"conduit",
+	"confess",
+	"conform",
+	"confuse",
+	"conical",
+	"conjure",
+	"conning",
+	"conquer",
+	"console",
+	"consult",
+	"consume",
+	"contend",
+	"contort",
+	"contour",
+	"convene",
+	"convent",
+	"cookers",
+	"cooking",
+	"coolers",
+	"coolest",
+	"cooling",
+	"copilot",
+	"copious",
+	"copying",
+	"cordial",
+	"corncob",
+	"corners",
+	"coroner",
+	"corpses",
+	"corrode",
+	"corrupt",
+	"corsage",
+	"costing",
+	"costume",
+	"cottage",
+	"couches",
+	"coughed",
+	"counted",
+	"coupled",
+	"couples",
+	"coupons",
+	"courier",
+	"courses",
+	"courted",
+	"cousins",
+	"covered",
+	"coveted",
+	"coyness",
+	"cozying",
+	"cracked",
+	"cracker",
+	"crafted",
+	"crafter",
+	"cramped",
+	"cranial",
+	"cranium",
+	"cranked",
+	"crashed",
+	"crashes",
+	"cravats",
+	"craving",
+	"crawled",
+	"crayons",
+	"crazies",
+	"crazily",
+	"creamed",
+	"creamer",
+	"created",
+	"creates",
+	"creator",
+	"credits",
+	"creeped",
+	"crested",
+	"crevice",
+	"crewman",
+	"cricket",
+	"crimson",
+	"crinkle",
+	"crinkly",
+	"crisped",
+	"crisply",
+	"critter",
+	"crochet",
+	"cronies",
+	"crooked",
+	"crossed",
+	"crosses",
+	"crouton",
+	"crowbar",
+	"crowded",
+	"crowned",
+	"crudely",
+	"cruelly",
+	"cruelty",
+	"crumble",
+	"crumpet",
+	"crunchy",
+	"crushed",
+	"crusher",
+	"crushes",
+	"cryptic",
+	"cubical",
+	"cubicle",
+	"cuddles",
+	"cuisine",
+	"culprit",
+	"cupcake",
+	"cupping",
+	"curable",
+	"curator",
+	"curling",
+	"cursing",
+	"cursive",
+	"curtain",
+	"cushion",
+	"custard",
+	"custody",
+	"customs",
+	"cycling",
+	"cyclist",
+	"cyclone",
+	"daddies",
+	"dailies",
+	"daisies",
+	"damaged",
+	"damages",
+	"dancers",
+	"dancing",
+	"dangers",
+	"darkest",
+	"darkish",
+	"darling",
+	"dashing",
+	"dawdler",
+	"daycare",
+	"daylong",
+	"dayroom",
+	"daytime",
+	"dazzled",
+	"dazzler",
+	"dazzles",
+	"dealers",
+	"dearest",
+	"deathly",
+	"debated",
+	"debates",
+	"debrief",
+	"decades",
+	"deceits",
+	"deceive",
+	"decency",
+	"decibel",
+	"decides",
+	"decimal",
+	"declare",
+	"decoder",
+	"decrees",
+	"deepest",
+	"defeats",
+	"defects",
+	"defends",
+	"defense",
+	"defiant",
+	"defined",
+	"defines",
+	"deflate",
+	"defraud",
+	"defrost",
+	"defying",
+	"degrade",
+	"degrees",
+	"deities",
+	"delayed",
+	"deleted",
+	"delight",
+	"delouse",
+	"demands",
+	"demoted",
+	"densely",
+	"dentist",
+	"denture",
+	"denying",
+	"depends",
+	"depicts",
+	"deplete",
+	"depress",
+	"deprive",
+	"derived",
+	"descend",
+	"deserve",
+	"designs",
+	"desired",
+	"desires",
+	"despair",
+	"despise",
+	"dessert",
+	"destiny",
+	"details",
+	"detects",
+	"detract",
+	"devalue",
+	"deviant",
+	"deviate",
+	"devices",
+	"deviled",
+	"devious",
+	"devised",
+	"devotee",
+	"diagram",
+	"dialing",
+	"diapers",
+	"diaries",
+	"dictate",
+	"dieting",
+	"digging",
+	"dilated",
+	"dimness",
+	"dingbat",
+	"dinners",
+	"diocese",
+	"dioxide",
+	"diploma",
+	"dipping",
+	"disable",
+	"disband",
+	"discard",
+	"discern",
+	"disdain",
+	"disjoin",
+	"dislike",
+	"dismiss",
+	"disobey",
+	"dispose",
+	"disrupt",
+	"distill",
+	"distort",
+	"disturb",
+	"ditched",
+	"ditches",
+	"divorce",
+	"docking",
+	"doctors",
+	"dodgers",
+	"dodging",
+	"dogging",
+	"dollars",
+	"dolphin",
+	"donated",
+	"donator",
+	"donkeys",
+	"doorman",
+	"doormat",
+	"doorway",
+	"dormant",
+	"doubles",
+	"doubted",
+	"douches",
+	"drafted",
+	"dragged",
+	"dragons",
+	"drained",
+	"drainer",
+	"drapery",
+	"drastic",
+	"drawers",
+	"dreaded",
+	"dreamed",
+	"dressed",
+	"dresser",
+	"dresses",
+	"dribble",
+	"drifted",
+	"drilled",
+	"driller",
+	"drivers",
+	"drizzle",
+	"drizzly",
+	"dropbox",
+	"droplet",
+	"dropout",
+	"dropped",
+	"dropper",
+	"drowned",
+	"drugged",
+	"duality",
+	"dubious",
+	"duchess",
+	"ducking",
+	"dueling",
+	"dullest",
+	"dumbest",
+	"dummies",
+	"dumping",
+	"dungeon",
+	"durable",
+	"durably",
+	"dusting",
+	"dutiful",
+	"dwelled",
+	"dweller",
+	"dwindle",
+	"dynasty",
+	"eagerly",
+	"earache",
+	"eardrum",
+	"earflap",
+	"earlier",
+	"earlobe",
+	"earmark",
+	"earmuff",
+	"earning",
+	"earring",
+	"earshot",
+	"earthen",
+	"earthly",
+	"easeful",
+	"easiest",
+	"eatable",
+	"eclipse",
+	"ecology",
+	"edifies",
+	"editing",
+	"editors",
+	"educate",
+	"effects",
+	"efforts",
+	"egotism",
+	"elapsed",
+	"elastic",
+	"elected",
+	"elegant",
+	"elevate",
+	"elitism",
+	"ellipse",
+	"eloping",
+	"elusive",
+	"embargo",
+	"embassy",
+	"emblaze",
+	"embrace",
+	"embryos",
+	"emerald",
+	"emerged",
+	"emerges",
+	"emitted",
+	"emotion",
+	"empathy",
+	"emperor",
+	"empires",
+	"employs",
+	"empower",
+	"emptied",
+	"emptier",
+	"empties",
+	"enables",
+	"enchant",
+	"enclose",
+	"encoded",
+	"encrust",
+	"encrypt",
+	"endings",
+	"endless",
+	"endnote",
+	"endorse",
+	"endowed",
+	"enemies",
+	"enforce",
+	"engines",
+	"engorge",
+	"engross",
+	"enjoyed",
+	"enjoyer",
+	"enlists",
+	"enraged",
+	"enslave",
+	"ensnare",
+	"entails",
+	"entered",
+	"entitle",
+	"entries",
+	"entropy",
+	"entrust",
+	"entwine",
+	"envious",
+	"enzymes",
+	"episode",
+	"equally",
+	"equator",
+	"equinox",
+	"erasers",
+	"erasing",
+	"erasure",
+	"errands",
+	"erratic",
+	"erupted",
+	"escaped",
+	"escapes",
+	"eskimos",
+	"esquire",
+	"estates",
+	"etching",
+	"eternal",
+	"ethanol",
+	"ethical",
+	"evacuee",
+	"evading",
+	"evasion",
+	"evasive",
+	"everest",
+	"evicted",
+	"evolved",
+	"evolves",
+	"exalted",
+	"excerpt",
+	"exclaim",
+	"excused",
+	"excuses",
+	"execute",
+	"exerted",
+	"exhaust",
+	"existed",
+	"exiting",
+	"exotics",
+	"expands",
+	"expanse",
+	"expects",
+	"experts",
+	"expired",
+	"expires",
+	"explode",
+	"exploit",
+	"exports",
+	"exposed",
+	"exposer",
+	"exposes",
+	"extends",
+	"extinct",
+	"extrude",
+	"fabrics",
+	"faceted",
+	"facials",
+	"faction",
+	"factoid",
+	"factors",
+	"factual",
+	"fainted",
+	"fairest",
+	"fairies",
+	"falcons",
+	"falling",
+	"falsely",
+	"falsify",
+	"fanatic",
+	"fancied",
+	"fancies",
+	"fanfare",
+	"fanning",
+	"fantasy",
+	"farmers",
+	"farming",
+	"farther",
+	"fascism",
+	"fastest",
+	"fasting",
+	"fathers",
+	"favored",
+	"fawning",
+	"fearful",
+	"fearing",
+	"feather",
+	"fedoras",
+	"feeding",
+	"fencing",
+	"ferment",
+	"festive",
+	"fetched",
+	"fetches",
+	"fetuses",
+	"fiddler",
+	"fidgety",
+	"fifties",
+	"figment",
+	"figured",
+	"figures",
+	"filming",
+	"filters",
+	"finally",
+	"finders",
+	"fingers",
+	"finicky",
+	"finless",
+	"finlike",
+	"firefly",
+	"firstly",
+	"fitting",
+	"fixture",
+	"flaccid",
+	"flagged",
+	"flagman",
+	"flaired",
+	"flakily",
+	"flaming",
+	"flanked",
+	"flannel",
+	"flaring",
+	"flashed",
+	"flashes",
+	"flatbed",
+	"flatten",
+	"flatter",
+	"flattop",
+	"flavors",
+	"flavour",
+	"fleeing",
+	"fleshed",
+	"flicker",
+	"flights",
+	"flipped",
+	"flirted",
+	"floated",
+	"flooded",
+	"florist",
+	"flowers",
+	"flowing",
+	"flunked",
+	"flushed",
+	"fluster",
+	"flyable",
+	"flyaway",
+	"flyover",
+	"foaming",
+	"focused",
+	"focuses",
+	"folders",
+	"folding",
+	"foliage",
+	"follows",
+	"fooling",
+	"footage",
+	"footing",
+	"footman",
+	"footpad",
+	"footsie",
+	"forcing",
+	"foresee",
+	"forests",
+	"forfeit",
+	"forgets",
+	"forging",
+	"forgive",
+	"forming",
+	"forties",
+	"fossils",
+	"founded",
+	"fragile",
+	"frailty",
+	"framing",
+	"frankly",
+	"frantic",
+	"fraying",
+	"freaked",
+	"freckle",
+	"freebee",
+	"freebie",
+	"freeing",
+	"freeway",
+	"freezer",
+	"freezes",
+	"freight",
+	"freshly",
+	"fretful",
+	"fretted",
+	"fridays",
+	"friends",
+	"frisbee",
+	"fritter",
+	"frosted",
+	"fulfill",
+	"fullest",
+	"fundies",
+	"funding",
+	"furnish",
+	"fussing",
+	"futures",
+	"gabbing",
+	"gadgets",
+	"gaining",
+	"gallant",
+	"gallons",
+	"gallops",
+	"gallows",
+	"gambled",
+	"ganging",
+	"gangway",
+	"garages",
+	"garbage",
+	"gardens",
+	"garland",
+	"garlics",
+	"garment",
+	"garnish",
+	"gasping",
+	"gathers",
+	"gauging",
+	"gazelle",
+	"generic",
+	"gentile",
+	"gentler",
+	"geology",
+	"gestate",
+	"gesture",
+	"getaway",
+	"getting",
+	"ghastly",
+	"gherkin",
+	"giddily",
+	"gimmick",
+	"gingers",
+	"giraffe",
+	"gizzard",
+	"glacial",
+	"glacier",
+	"glamour",
+	"glanced",
+	"glances",
+	"glaring",
+	"glasses",
+	"glazing",
+	"gleeful",
+	"gliders",
+	"gliding",
+	"glimmer",
+	"glimpse",
+	"glisten",
+	"glitter",
+	"gloater",
+	"glorify",
+	"glowing",
+	"glucose",
+	"glutton",
+	"gnawing",
+	"goblins",
+	"goggles",
+	"goliath",
+	"gondola",
+	"goodbye",
+	"goodies",
+	"gorilla",
+	"gosling",
+	"gospels",
+	"gossips",
+	"gourmet",
+	"grabbed",
+	"graders",
+	"grading",
+	"grafted",
+	"grammar",
+	"grandly",
+	"grandma",
+	"grandpa",
+	"granite",
+	"granola",
+	"granted",
+	"graphic",
+	"grapple",
+	"grasped",
+	"gratify",
+	"grating",
+	"gravity",
+	"grazing",
+	"greased",
+	"greases",
+	"greatly",
+	"greener",
+	"greeted",
+	"greeter",
+	"grenade",
+	"griddle",
+	"grifter",
+	"grilled",
+	"grimace",
+	"gristle",
+	"grocery",
+	"groomed",
+	"groping",
+	"grounds",
+	"grouped",
+	"growing",
+	"growths",
+	"grudges",
+	"gruffly",
+	"grumble",
+	"grumbly",
+	"guarded",
+	"guessed",
+	"guesses",
+	"guiding",
+	"guitars",
+	"gumball",
+	"gumdrop",
+	"gumming",
+	"gushing",
+	"gutless",
+	"gutters",
+	"guzzler",
+	"gypsies",
+	"habitat",
+	"hackers",
+	"hacking",
+	"hacksaw",
+	"haggler",
+	"haircut",
+	"halfway",
+	"halibut",
+	"halogen",
+	"hammers",
+	"hammock",
+	"hamster",
+	"handbag",
+	"handful",
+	"handgun",
+	"handing",
+	"handled",
+	"handler",
+	"handles",
+	"handoff",
+	"handsaw",
+	"handset",
+	"hangers",
+	"hangout",
+	"happens",
+	"happier",
+	"happily",
+	"hardest",
+	"hardhat",
+	"harmful",
+	"harming",
+	"harmony",
+	"harness",
+	"harping",
+	"harpist",
+	"harshly",
+	"harvest",
+	"hassled",
+	"hassles",
+	"hastily",
+	"hatched",
+	"hatchet",
+	"hatless",
+	"hatreds",
+	"haunted",
+	"hawking",
+	"headset",
+	"headway",
+	"healing",
+	"hearted",
+	"heating",
+	"heavier",
+	"heaving",
+	"hedging",
+	"heelses",
+	"heights",
+	"helmets",
+	"helpers",
+	"hemlock",
+	"herbses",
+	"heroics",
+	"heroine",
+	"heroism",
+	"herring",
+	"hexagon",
+	"hiccups",
+	"hickory",
+	"highest",
+	"hilltop",
+	"hinting",
+	"hippies",
+	"hitched",
+	"hitting",
+	"hoarder",
+	"hobbies",
+	"hogging",
+	"hoisted",
+	"holders",
+	"honored",
+	"honours",
+	"hooking",
+	"horizon",
+	"horrors",
+	"hoseses",
+	"hostels",
+	"hosting",
+	"hounded",
+	"huddled",
+	"hugging",
+	"humanly",
+	"humbled",
+	"humming",
+	"humping",
+	"hunches",
+	"hunters",
+	"hunting",
+	"hurdles",
+	"hurling",
+	"hurried",
+	"hurries",
+	"hurting",
+	"hybrids",
+	"hydrant",
+	"hygiene",
+	"iceberg",
+	"iciness",
+	"ideally",
+	"ignored",
+	"ignores",
+	"iguanas",
+	"imitate",
+	"immerse",
+	"impacts",
+	"impasse",
+	"impeach",
+	"implant",
+	"implied",
+	"implies",
+	"implode",
+	"imports",
+	"imposed",
+	"impound",
+	"impress",
+	"imprint",
+	"impulse",
+	"inbound",
+	"incline",
+	"indices",
+	"induced",
+	"indulge",
+	"infancy",
+	"inflate",
+	"informs",
+	"inherit",
+	"inkling",
+	"inkwell",
+	"inmates",
+	"inquire",
+	"insects",
+	"inserts",
+	"insides",
+	"insists",
+	"inspect",
+	"insulin",
+	"intends",
+	"intents",
+	"interns",
+	"invalid",
+	"inverse",
+	"invited",
+	"iridium",
+	"ironing",
+	"islamic",
+	"islands",
+	"isolate",
+	"isotope",
+	"issuing",
+	"italics",
+	"itching",
+	"jackets",
+	"jackpot",
+	"jaguars",
+	"jammies",
+	"jamming",
+	"janitor",
+	"jarring",
+	"jasmine",
+	"javelin",
+	"jawless",
+	"jawline",
+	"jaybird",
+	"jellied",
+	"jerking",
+	"jerseys",
+	"jeweler",
+	"jewelry",
+	"jitters",
+	"jittery",
+	"jockeys",
+	"jogging",
+	"joining",
+	"joyride",
+	"judging",
+	"juggler",
+	"jugular",
+	"juicers",
+	"jujitsu",
+	"jukebox",
+	"jumpers",
+	"jumping",
+	"jungles",
+	"juniper",
+	"junkies",
+	"junkman",
+	"karaoke",
+	"keepers",
+	"kestrel",
+	"ketchup",
+	"kettles",
+	"kicking",
+	"kiddies",
+	"kidding",
+	"kidnaps",
+	"kindest",
+	"kindred",
+	"kinetic",
+	"kinfolk",
+	"kingpin",
+	"kinship",
+	"kinsman",
+	"kissing",
+	"kitties",
+	"kleenex",
+	"knitted",
+	"knocked",
+	"krypton",
+	"labeled",
+	"labored",
+	"laborer",
+	"lacking",
+	"ladybug",
+	"lagging",
+	"lantern",
+	"lapping",
+	"laptops",
+	"largest",
+	"lashing",
+	"latrine",
+	"laughed",
+	"launder",
+	"laundry",
+	"lawsuit",
+	"lawyers",
+	"leaders",
+	"leagues",
+	"leaking",
+	"leaning",
+	"leaping",
+	"learner",
+	"lecture",
+	"ledgers",
+	"leeches",
+	"legally",
+	"legible",
+	"legibly",
+	"legroom",
+	"legwork",
+	"lending",
+	"lengths",
+	"lengthy",
+	"lenient",
+	"leopard",
+	"leotard",
+	"lessons",
+	"letdown",
+	"letters",
+	"letting",
+	"lettuce",
+	"leveled",
+	"licking",
+	"lifting",
+	"liftoff",
+	"lighten",
+	"lighter",
+	"lightly",
+	"limeade",
+	"limping",
+	"lineage",
+	"linking",
+	"linseed",
+	"liquefy",
+	"liqueur",
+	"liquids",
+	"listens",
+	"literal",
+	"livable",
+	"lividly",
+	"lizards",
+	"loading",
+	"loafers",
+	"loaning",
+	"loathes",
+	"lobster",
+	"locally",
+	"located",
+	"lockers",
+	"locking",
+	"lodging",
+	"logging",
+	"londons",
+	"longest",
+	"longing",
+	"looking",
+	"looming",
+	"loosely",
+	"loosing",
+	"lowered",
+	"luckily",
+	"luggage",
+	"lullaby",
+	"lumping",
+	"lumpish",
+	"lunches",
+	"lurking",
+	"lustily",
+	"magenta",
+	"maggots",
+	"magical",
+	"magnets",
+	"magnify",
+	"mailbox",
+	"mailing",
+	"majesty",
+	"majored",
+	"majorly",
+	"makings",
+	"mammals",
+	"mammary",
+	"mammoth",
+	"managed",
+	"manages",
+	"manatee",
+	"mandate",
+	"mangled",
+	"manhole",
+	"manhood",
+	"manhunt",
+	"mankind",
+	"manlike",
+	"manmade",
+	"manners",
+	"mannish",
+	"manuals",
+	"mapping",
+	"marbled",
+	"marbles",
+	"marched",
+	"marches",
+	"margins",
+	"marines",
+	"marital",
+	"markers",
+	"markets",
+	"marking",
+	"marries",
+	"marshal",
+	"marxism",
+	"mascara",
+	"mashing",
+	"masking",
+	"massage",
+	"mastiff",
+	"matador",
+	"matched",
+	"matcher",
+	"matches",
+	"matters",
+	"matured",
+	"meander",
+	"meanest",
+	"melting",
+	"members",
+	"mercies",
+	"mermaid",
+	"messing",
+	"methods",
+	"metrics",
+	"migrant",
+	"milking",
+	"minaret",
+	"minding",
+	"minutes",
+	"mirrors",
+	"mistook",
+	"moaning",
+	"mobiles",
+	"mobster",
+	"mockery",
+	"mocking",
+	"modular",
+	"moisten",
+	"mollusk",
+	"moments",
+	"mommies",
+	"mongrel",
+	"monkeys",
+	"monsoon",
+	"monster",
+	"moocher",
+	"mooning",
+	"moonlit",
+	"mopping",
+	"morally",
+	"mortify",
+	"mothers",
+	"motions",
+	"motives",
+	"mounted",
+	"mourned",
+	"mourner",
+	"mouthed",
+	"movable",
+	"mucking",
+	"muffins",
+	"mugging",
+	"mullets",
+	"mummies",
+	"mummify",
+	"munches",
+	"mundane",
+	"murders",
+	"muscles",
+	"museums",
+	"mushily",
+	"musical",
+	"mustang",
+	"mustard",
+	"musters",
+	"mutable",
+	"mutated",
+	"myspace",
+	"mystery",
+	"mystify",
+	"nagging",
+	"nailing",
+	"nannies",
+	"napkins",
+	"napping",
+	"narrows",
+	"narwhal",
+	"nastily",
+	"nations",
+	"natives",
+	"natures",
+	"nearest",
+	"nearing",
+	"needing",
+	"needles",
+	"neglect",
+	"negroes",
+	"nemeses",
+	"nemesis",
+	"nephews",
+	"nesting",
+	"neurons",
+	"neutron",
+	"nighter",
+	"nightly",
+	"nomadic",
+	"nominee",
+	"noodles",
+	"normals",
+	"nostril",
+	"notably",
+	"notches",
+	"noticed",
+	"notices",
+	"notions",
+	"nucleus",
+	"nullify",
+	"numbers",
+	"numbing",
+	"numeric",
+	"nursery",
+	"nurture",
+	"nutcase",
+	"nutlike",
+	"nuzzled",
+	"oatmeal",
+	"obliged",
+	"obscure",
+	"observe",
+	"occured",
+	"octagon",
+	"octopus",
+	"odyssey",
+	"offends",
+	"offered",
+	"offices",
+	"ominous",
+	"omitted",
+	"omnibus",
+	"onboard",
+	"onshore",
+	"onstage",
+	"opacity",
+	"opossum",
+	"opposed",
+	"optimal",
+	"optimum",
+	"options",
+	"oranges",
+	"orchard",
+	"orchids",
+	"ordered",
+	"orderly",
+	"origins",
+	"osmosis",
+	"ostrich",
+	"outback",
+	"outcast",
+	"outfits",
+	"outgrow",
+	"outlast",
+	"outlets",
+	"outline",
+	"outmost",
+	"outpost",
+	"outpour",
+	"outrage",
+	"outrank",
+	"outsell",
+	"outward",
+	"ovaries",
+	"overact",
+	"overbid",
+	"overdue",
+	"overfed",
+	"overlap",
+	"overlay",
+	"overpay",
+	"overrun",
+	"overtly",
+	"overuse",
+	"oxidant",
+	"oxidize",
+	"oysters",
+	"packets",
+	"packing",
+	"padding",
+	"paddles",
+	"paddock",
+	"padlock",
+	"pagodas",
+	"painful",
+	"painter",
+	"pajamas",
+	"palette",
+	"pampers",
+	"pancake",
+	"panning",
+	"panther",
+	"panting",
+	"pantses",
+	"paprika",
+	"papyrus",
+	"parades",
+	"paradox",
+	"parched",
+	"parents",
+	"parfume",
+	"parkway",
+	"paroled",
+	"parrots",
+	"parsley",
+	"parsnip",
+	"partake",
+	"partied",
+	"parties",
+	"parting",
+	"pastime",
+	"pasture",
+	"patched",
+	"patches",
+	"patriot",
+	"patrols",
+	"pausing",
+	"payback",
+	"payroll",
+	"peacock",
+	"pebbles",
+	"pecking",
+	"peeking",
+	"peeling",
+	"peeping",
+	"pelican",
+	"pellets",
+	"pencils",
+	"pendant",
+	"penguin",
+	"penises",
+	"pennant",
+	"pennies",
+	"peoples",
+	"perched",
+	"perform",
+	"perfume",
+	"periods",
+	"perjury",
+	"permits",
+	"persist",
+	"persons",
+	"petrify",
+	"petunia",
+	"phantom",
+	"phoenix",
+	"phonics",
+	"phoning",
+	"pierced",
+	"pierces",
+	"pigeons",
+	"pilgrim",
+	"pillows",
+	"pinched",
+	"pinning",
+	"pitched",
+	"pitcher",
+	"pitches",
+	"placard",
+	"placate",
+	"placing",
+	"plainly",
+	"planets",
+	"planing",
+	"planned",
+	"planner",
+	"planted",
+	"plaster",
+	"plating",
+	"platter",
+	"players",
+	"playful",
+	"playing",
+	"playoff",
+	"playpen",
+	"playset",
+	"pleaded",
+	"pleased",
+	"pleases",
+	"pledged",
+	"pledges",
+	"pliable",
+	"plotted",
+	"plucked",
+	"plugged",
+	"plumber",
+	"plunder",
+	"plunged",
+	"plunger",
+	"plywood",
+	"poached",
+	"pockets",
+	"pointer",
+	"poisons",
+	"polices",
+	"polling",
+	"pollute",
+	"polygon",
+	"polymer",
+	"popcorn",
+	"poppies",
+	"popping",
+	"porcine",
+	"portals",
+	"possess",
+	"postage",
+	"postbox",
+	"posters",
+	"posting",
+	"posture",
+	"postwar",
+	"potatos",
+	"pottery",
+	"pouches",
+	"poultry",
+	"pounces",
+	"pouring",
+	"pouting",
+	"powdery",
+	"powered",
+	"prairie",
+	"praised",
+	"praises",
+	"pranker",
+	"prayers",
+	"praying",
+	"preachy",
+	"precede",
+	"precook",
+	"preface",
+	"prefers",
+	"pregame",
+	"prelude",
+	"prepaid",
+	"preplan",
+	"prepped",
+	"presets",
+	"preshow",
+	"presoak",
+	"pressed",
+	"presses",
+	"presume",
+	"preteen",
+	"pretend",
+	"pretext",
+	"pretzel",
+	"prevail",
+	"preview",
+	"prickly",
+	"primate",
+	"princes",
+	"printed",
+	"prisons",
+	"probing",
+	"prodded",
+	"prodigy",
+	"profane",
+	"profits",
+	"progeny",
+	"prolong",
+	"prompts",
+	"propose",
+	"prorate",
+	"prouder",
+	"proudly",
+	"proving",
+	"provoke",
+	"prowess",
+	"prowler",
+	"prudent",
+	"pruning",
+	"psyched",
+	"psychic",
+	"puffing",
+	"pulling",
+	"pulsate",
+	"pumping",
+	"pumpkin",
+	"punched",
+	"punches",
+	"pungent",
+	"puppets",
+	"purging",
+	"puritan",
+	"pursued",
+	"pursuit",
+	"pushpin",
+	"pushups",
+	"putdown",
+	"putting",
+	"puzzled",
+	"puzzles",
+	"pyramid",
+	"quaking",
+	"quantum",
+	"quarrel",
+	"quartet",
+	"queries",
+	"quibble",
+	"quicken",
+	"quicker",
+	"quickly",
+	"quieter",
+	"quietly",
+	"quintet",
+	"quitter",
+	"quivers",
+	"quizzes",
+	"quoting",
+	"rabbits",
+	"raccoon",
+	"rackets",
+	"racking",
+	"radiant",
+	"radioed",
+	"rafters",
+	"rafting",
+	"ragweed",
+	"raiders",
+	"raiding",
+	"railcar",
+	"railing",
+	"rainbow",
+	"raining",
+	"raising",
+	"raisins",
+	"rallies",
+	"rampart",
+	"rancher",
+	"randoms",
+	"ranging",
+	"ranking",
+	"ransack",
+	"ranting",
+	"rapidly",
+	"rapture",
+	"rasping",
+	"ratched",
+	"ratings",
+	"rattled",
+	"ravioli",
+	"reached",
+	"reaches",
+	"reacted",
+	"reactor",
+	"reapply",
+	"reasons",
+	"reawake",
+	"rebirth",
+	"rebound",
+	"rebuild",
+	"rebuilt",
+	"receded",
+	"recheck",
+	"recipes",
+	"recital",
+	"reclaim",
+	"recline",
+	"recluse",
+	"recolor",
+	"records",
+	"recount",
+	"rectify",
+	"recycle",
+	"redoing",
+	"reduced",
+	"reeling",
+	"reenact",
+	"reenter",
+	"reentry",
+	"referee",
+	"refills",
+	"refined",
+	"refocus",
+	"reforms",
+	"refract",
+	"refrain",
+	"refresh",
+	"refried",
+	"refusal",
+	"refused",
+	"refuses",
+	"regains",
+	"regalia",
+	"regally",
+	"regards",
+	"regimes",
+	"regions",
+	"regress",
+	"regroup",
+	"reissue",
+	"rejects",
+	"rejoice",
+	"relapse",
+	"relates",
+	"relaxed",
+	"relaxes",
+	"relearn",
+	"relents",
+	"reliant",
+	"relieve",
+	"relight",
+	"relying",
+	"remarks",
+	"remarry",
+	"rematch",
+	"reminds",
+	"remnant",
+	"remorse",
+	"remotes",
+	"remover",
+	"removes",
+	"renders",
+	"renewal",
+	"renewed",
+	"renters",
+	"renting",
+	"reoccur",
+	"reorder",
+	"repaint",
+	"repairs",
+	"repeats",
+	"replays",
+	"replica",
+	"replied",
+	"reports",
+	"reposts",
+	"reprint",
+	"reprise",
+	"reptile",
+	"reroute",
+	"rescued",
+	"rescuer",
+	"rescues",
+	"reshape",
+	"reshoot",
+	"resided",
+	"resides",
+	"residue",
+	"restart",
+	"resting",
+	"results",
+	"resumes",
+	"retards",
+	"rethink",
+	"retinal",
+	"retiree",
+	"retouch",
+	"retrace",
+	"retract",
+	"retrain",
+	"retread",
+	"retreat",
+	"retrial",
+	"returns",
+	"retying",
+	"reunion",
+	"reunite",
+	"reveals",
+	"reveler",
+	"revenge",
+	"revered",
+	"reviews",
+	"revised",
+	"revisit",
+	"revival",
+	"revived",
+	"reviver",
+	"revoked",
+	"revolts",
+	"revolve",
+	"rewards",
+	"rewrite",
+	"rhyming",
+	"rhythms",
+	"ribbons",
+	"ribcage",
+	"richest",
+	"rickety",
+	"ricotta",
+	"riddled",
+	"riddles",
+	"rifling",
+	"rigging",
+	"rightly",
+	"rimless",
+	"ringing",
+	"rinsing",
+	"rioters",
+	"ripcord",
+	"ripping",
+	"riptide",
+	"risking",
+	"risotto",
+	"ritalin",
+	"riveter",
+	"roaches",
+	"roadmap",
+	"roaming",
+	"roaring",
+	"roasted",
+	"robbing",
+	"rocking",
+	"rodents",
+	"rollers",
+	"rolling",
+	"roomies",
+	"rooming",
+	"rooster",
+	"rooting",
+	"rotting",
+	"rotunda",
+	"roughed",
+	"roughly",
+	"rounded",
+	"roundup",
+	"rousing",
+	"routers",
+	"routing",
+	"royally",
+	"rubbing",
+	"rubdown",
+	"ruining",
+	"rummage",
+	"rumored",
+	"rundown",
+	"runners",
+	"rupture",
+	"sabbath",
+	"saddens",
+	"saddled",
+	"sadness",
+	"saffron",
+	"sagging",
+	"sainted",
+	"saintly",
+	"salvage",
+	"samples",
+	"sandals",
+	"sandbag",
+	"sandbar",
+	"sandbox",
+	"sanding",
+	"sandlot",
+	"sandpit",
+	"sapling",
+	"sarcasm",
+	"sardine",
+	"satchel",
+	"saucers",
+	"sausage",
+	"savages",
+	"savanna",
+	"savings",
+	"scabbed",
+	"scalded",
+	"scaling",
+	"scallop",
+	"scammed",
+	"scandal",
+	"scanned",
+	"scanner",
+	"scarily",
+	"scaring",
+	"scarlet",
+	"scarred",
+	"scatter",
+	"scented",
+	"schemer",
+	"schemes",
+	"scholar",
+	"schools",
+	"scooped",
+	"scooter",
+	"scoring",
+	"scorned",
+	"scoured",
+	"scraped",
+	"scrapes",
+	"scratch",
+	"scrawny",
+	"screams",
+	"screens",
+	"screwed",
+	"scripts",
+	"scrolls",
+	"scrooge",
+	"scruffy",
+	"scrunch",
+	"scuttle",
+	"seafood",
+	"seagull",
+	"seasons",
+	"seating",
+	"seconds",
+	"secrecy",
+	"secrets",
+	"secular",
+	"secured",
+	"sedated",
+	"seduced",
+	"seduces",
+	"seekers",
+	"seeming",
+	"seismic",
+	"seizing",
+	"selects",
+	"selling",
+	"seltzer",
+	"seminar",
+	"senator",
+	"sending",
+	"seniors",
+	"sensing",
+	"sensors",
+	"sequoia",
+	"serpent",
+	"servers",
+	"setback",
+	"settled",
+	"seventy",
+	"severed",
+	"shacked",
+	"shadily",
+	"shading",
+	"shadows",
+	"shakily",
+	"shaking",
+	"shallot",
+	"shallow",
+	"shampoo",
+	"shaping",
+	"sharing",
+	"sharper",
+	"sharpie",
+	"sharply",
+	"shaving",
+	"shelled",
+	"shelter",
+	"shelves",
+	"sherbet",
+	"shifted",
+	"shifter",
+	"shiller",
+	"shimmer",
+	"shindig",
+	"shingle",
+	"shining",
+	"shipped",
+	"shocked",
+	"shoeses",
+	"shopped",
+	"shopper",
+	"shorted",
+	"shorten",
+	"shorter",
+	"shouted",
+	"shoving",
+	"showbiz",
+	"showers",
+	"showman",
+	"showoff",
+	"shrimps",
+	"shrinks",
+	"shrivel",
+	"shudder",
+	"shuffle",
+	"shunned",
+	"siamese",
+	"sibling",
+	"sickest",
+	"sidecar",
+	"sifting",
+	"sighing",
+	"sighted",
+	"signals",
+	"signify",
+	"signing",
+	"simpler",
+	"sincere",
+	"singers",
+	"singing",
+	"singled",
+	"singles",
+	"sinking",
+	"sinless",
+	"sinners",
+	"sinuous",
+	"sinuses",
+	"sipping",
+	"sisters",
+	"sitters",
+	"sixfold",
+	"sixties",
+	"sizable",
+	"sizably",
+	"skating",
+	"skeptic",
+	"skillet",
+	"skimmed",
+	"skimmer",
+	"skinned",
+	"skipped",
+	"skipper",
+	"skittle",
+	"skyline",
+	"skyward",
+	"slacked",
+	"slacker",
+	"slammed",
+	"slander",
+	"slapped",
+	"slashed",
+	"slasher",
+	"slather",
+	"slavery",
+	"slaving",
+	"sleeves",
+	"slicing",
+	"slicker",
+	"sliders",
+	"sliding",
+	"slipped",
+	"slogans",
+	"sloping",
+	"slouchy",
+	"slowing",
+	"slugged",
+	"smacked",
+	"smaller",
+	"smarter",
+	"smartly",
+	"smashed",
+	"smasher",
+	"smashup",
+	"smeared",
+	"smelled",
+	"smiling",
+	"smitten",
+	"smokers",
+	"smolder",
+	"smother",
+	"smuggle",
+	"snagged",
+	"snaking",
+	"snapped",
+	"sneaked",
+	"sneaker",
+	"sneezed",
+	"sneezes",
+	"snipers",
+	"snippet",
+	"snooper",
+	"snoring",
+	"snorkel",
+	"snowcap",
+	"snowing",
+	"snowman",
+	"snuggle",
+	"soaking",
+	"soaring",
+	"sockets",
+	"sockses",
+	"softens",
+	"solidly",
+	"solving",
+	"someday",
+	"soprano",
+	"sorrows",
+	"sorting",
+	"sounded",
+	"sounder",
+	"sources",
+	"spammer",
+	"spanked",
+	"sparing",
+	"sparked",
+	"sparkly",
+	"sparrow",
+	"spatula",
+	"spawned",
+	"specify",
+	"specked",
+	"spelled",
+	"speller",
+	"spender",
+	"spewing",
+	"spheres",
+	"spiders",
+	"spiking",
+	"spilled",
+	"spinach",
+	"spindle",
+	"spinner",
+	"spinout",
+	"spirits",
+	"splashy",
+	"spliced",
+	"splurge",
+	"spoiled",
+	"spoiler",
+	"sponges",
+	"spooked",
+	"spotted",
+	"spotter",
+	"spousal",
+	"spouses",
+	"sprayed",
+	"spreads",
+	"sprints",
+	"sprouts",
+	"sputter",
+	"squared",
+	"squares",
+	"squeaks",
+	"squeeze",
+	"squishy",
+	"stabbed",
+	"stables",
+	"stacked",
+	"stadium",
+	"staffed",
+	"stagger",
+	"staging",
+	"stained",
+	"staking",
+	"stalked",
+	"stalker",
+	"stalled",
+	"stamina",
+	"stammer",
+	"stamped",
+	"stapled",
+	"stapler",
+	"stardom",
+	"staring",
+	"starlet",
+	"starlit",
+	"started",
+	"starter",
+	"startle",
+	"startup",
+	"starved",
+	"stashed",
+	"stating",
+	"statues",
+	"stature",
+	"statute",
+	"staunch",
+	"staying",
+	"steamed",
+	"steamer",
+	"steered",
+	"stellar",
+	"stencil",
+	"stepped",
+	"sterile",
+	"sternum",
+	"sticker",
+	"stiffen",
+	"stiffly",
+	"stimuli",
+	"stinger",
+	"stipend",
+	"stirred",
+	"stirrup",
+	"stocked",
+	"stomach",
+	"stomped",
+	"stoning",
+	"stooped",
+	"stopped",
+	"stopper",
+	"stories",
+	"storing",
+	"stormed",
+	"stowing",
+	"strains",
+	"strands",
+	"stratus",
+	"streams",
+	"streets",
+	"strides",
+	"striker",
+	"strikes",
+	"strings",
+	"striped",
+	"strudel",
+	"stubbed",
+	"stubble",
+	"stubbly",
+	"studies",
+	"studios",
+	"stuffed",
+	"stumble",
+	"stumped",
+	"stumper",
+	"stunned",
+	"stunner",
+	"stutter",
+	"styling",
+	"stylist",
+	"subdued",
+	"sublime",
+	"subplot",
+	"subside",
+	"subsidy",
+	"subsoil",
+	"subtext",
+	"subtype",
+	"suburbs",
+	"subzero",
+	"suction",
+	"suffers",
+	"suffice",
+	"suicide",
+	"sulfate",
+	"sulfide",
+	"sulfite",
+	"sulking",
+	"sunbeam",
+	"sundaes",
+	"sunrise",
+	"surfers",
+	"surgeon",
+	"surging",
+	"surname",
+	"surpass",
+	"surreal",
+	"suspend",
+	"swagger",
+	"swallow",
+	"swamped",
+	"sweater",
+	"sweeter",
+	"swelled",
+	"swerved",
+	"swifter",
+	"swiftly",
+	"swimmer",
+	"swinger",
+	"swizzle",
+	"swooned",
+	"symbols",
+	"symptom",
+	"synapse",
+	"syncing",
+	"synergy",
+	"synonym",
+	"systems",
+	"tabasco",
+	"tablets",
+	"tabloid",
+	"tacking",
+	"tackled",
+	"tactful",
+	"tactics",
+	"tactile",
+	"tadpole",
+	"tagging",
+	"tailing",
+	"tainted",
+	"talents",
+	"talkies",
+	"talking",
+	"tallest",
+	"tangent",
+	"tangled",
+	"tannery",
+	"tanning",
+	"tantrum",
+	"tapered",
+	"tapioca",
+	"tapping",
+	"targets",
+	"tarnish",
+	"tasting",
+	"tattoos",
+	"teaches",
+	"teaming",
+	"tearing",
+	"teasing",
+	"techies",
+	"tedious",
+	"tempest",
+	"temples",
+	"tempted",
+	"tenants",
+	"tending",
+	"thanked",
+	"theater",
+	"theorem",
+	"therein",
+	"thereof",
+	"thermal",
+	"thermos",
+	"thicken",
+	"thicker",
+	"thicket",
+	"thimble",
+	"thinker",
+	"thinner",
+	"thirdly",
+	"thirsty",
+	"thistle",
+	"threads",
+	"threats",
+	"thrills",
+	"thrives",
+	"throats",
+	"thrones",
+	"thrower",
+	"thunder",
+	"thyself",
+	"tickets",
+	"ticking",
+	"tickled",
+	"tickles",
+	"tidings",
+	"tighten",
+	"tighter",
+	"tightly",
+	"tigress",
+	"timings",
+	"tinfoil",
+	"tinwork",
+	"tipping",
+	"tissues",
+	"titties",
+	"toasted",
+	"toilets",
+	"tomatos",
+	"tongues",
+	"toothed",
+	"torched",
+	"torches",
+	"tornado",
+	"torture",
+	"tossing",
+	"totaled",
+	"touches",
+	"tougher",
+	"touring",
+	"tracing",
+	"tracked",
+	"tractor",
+	"trading",
+	"tragedy",
+	"trailer",
+	"trained",
+	"traitor",
+	"trapeze",
+	"trapped",
+	"trapper",
+	"trashed",
+	"travels",
+	"travers",
+	"treason",
+	"treated",
+	"trekker",
+	"trellis",
+	"tremble",
+	"tribune",
+	"tribute",
+	"triceps",
+	"tricked",
+	"trickle",
+	"trident",
+	"trilogy",
+	"trimmed",
+	"trimmer",
+	"trinity",
+	"trinket",
+	"tripped",
+	"triumph",
+	"trivial",
+	"trodden",
+	"trolled",
+	"tropics",
+	"troupes",
+	"truffle",
+	"trumped",
+	"trumpet",
+	"trusted",
+	"trustee",
+	"tsunami",
+	"tubular",
+	"tucking",
+	"tugboat",
+	"tuition",
+	"tumbler",
+	"tunnels",
+	"turbine",
+	"turkeys",
+	"turmoil",
+	"turrets",
+	"turtles",
+	"tweaked",
+	"twelfth",
+	"twiddle",
+	"twisted",
+	"twister",
+	"twitter",
+	"unaired",
+	"unarmed",
+	"unawake",
+	"unaware",
+	"unbaked",
+	"unblock",
+	"unboxed",
+	"uncanny",
+	"unchain",
+	"uncheck",
+	"uncivil",
+	"unclasp",
+	"unclean",
+	"unclear",
+	"uncloak",
+	"uncouth",
+	"uncover",
+	"uncross",
+	"uncrown",
+	"uncured",
+	"undated",
+	"undergo",
+	"undoing",
+	"undress",
+	"undying",
+	"unearth",
+	"uneaten",
+	"unequal",
+	"unfazed",
+	"unfiled",
+	"unfixed",
+	"ungodly",
+	"unhappy",
+	"unheard",
+	"unhinge",
+	"unicorn",
+	"unified",
+	"unifier",
+	"unkempt",
+	"unlaced",
+	"unlatch",
+	"unleash",
+	"unlined",
+	"unloads",
+	"unlocks",
+	"unloved",
+	"unlucky",
+	"unmixed",
+	"unmoral",
+	"unmount",
+	"unmoved",
+	"unnamed",
+	"unnerve",
+	"unpaved",
+	"unquote",
+	"unrated",
+	"unrobed",
+	"unsaved",
+	"unscrew",
+	"unstuck",
+	"unsworn",
+	"untaken",
+	"untamed",
+	"untaxed",
+	"untimed",
+	"untried",
+	"untruth",
+	"untwist",
+	"untying",
+	"unvocal",
+	"unweave",
+	"unwired",
+	"unwound",
+	"unwoven",
+	"upchuck",
+	"updated",
+	"updates",
+	"upfront",
+	"uploads",
+	"upright",
+	"upriver",
+	"upstage",
+	"upstart",
+	"upstate",
+	"upswing",
+	"uptight",
+	"upwards",
+	"uranium",
+	"urgency",
+	"urology",
+	"useable",
+	"useless",
+	"usually",
+	"utensil",
+	"utilize",
+	"uttered",
+	"utterly",
+	"vacancy",
+	"vacates",
+	"vaguely",
+	"valiant",
+	"valleys",
+	"vampire",
+	"vanilla",
+	"vantage",
+	"variant",
+	"varmint",
+	"varnish",
+	"varsity",
+	"varying",
+	"vectors",
+	"veggies",
+	"vending",
+	"venting",
+	"verbose",
+	"verdict",
+	"vertigo",
+	"vessels",
+	"vibrant",
+	"victims",
+	"viewers",
+	"villain",
+	"vintage",
+	"violate",
+	"violets",
+	"violins",
+	"viplate",
+	"virtues",
+	"viruses",
+	"viscous",
+	"visibly",
+	"visions",
+	"visited",
+	"visitor",
+	"vitally",
+	"vividly",
+	"vocally",
+	"voicing",
+	"volcano",
+	"voltage",
+	"volumes",
+	"vomited",
+	"voucher",
+	"vulture",
+	"waffles",
+	"wailing",
+	"waiters",
+	"waivers",
+	"walkers",
+	"wallaby",
+	"wallets",
+	"walmart",
+	"walnuts",
+	"waltzed",
+	"wanders",
+	"wannabe",
+	"warbler",
+	"warmest",
+	"warming",
+	"washday",
+	"washing",
+	"washout",
+	"washtub",
+	"wasting",
+	"watched",
+	"watcher",
+	"watches",
+	"watered",
+	"weakest",
+	"wealths",
+	"webpage",
+	"weeping",
+	"weighed",
+	"weights",
+	"weirded",
+	"weirder",
+	"weirdly",
+	"welding",
+	"wetland",
+	"wetting",
+	"whacked",
+	"wheeled",
+	"wherein",
+	"whining",
+	"whipped",
+	"whisked",
+	"whisker",
+	"whistle",
+	"whoever",
+	"whoopee",
+	"widgets",
+	"widowed",
+	"widower",
+	"wielded",
+	"wielder",
+	"wiggles",
+	"wildcat",
+	"wildest",
+	"willies",
+	"willows",
+	"wincing",
+	"winding",
+	"windows",
+	"winging",
+	"wingses",
+	"winking",
+	"wisdoms",
+	"wishing",
+	"wistful",
+	"witches",
+	"womanly",
+	"wonders",
+	"workers",
+	"worldly",
+	"worried",
+	"worrier",
+	"worries",
+	"wounded",
+	"wrangle",
+	"wrapped",
+	"wrapper",
+	"wreaked",
+	"wrecked",
+	"wrecker",
+	"wriggle",
+	"wriggly",
+	"wringer",
+	"wrinkle",
+	"wrinkly",
+	"writers",
+	"wronged",
+	"wrongly",
+	"wrought",
+	"yanking",
+	"yapping",
+	"yardarm",
+	"yelling",
+	"younger",
+	"zealots",
+	"zealous",
+	"zipfile",
+	"zipping",
+	"zombies",
+	"zoology",
+}
@@ -0,0 +1,3446 @@
+package wordle
+
+// answers for 8 letter games, in the order daily puzzles use them
+var words8 = []string{
+	"measured",
+	"republic",
+	"transfer",
+	"dressing",
+	"terrible",
+	"delivery",
+	"optimism",
+	"patience",
+	"instance",
+	"vertical",
+	"complain",
+	"treasury",
+	"everyone",
+	"finished",
+	"mountain",
+	"guidance",
+	"judgment",
+	"relevant",
+	"facility",
+	"minister",
+	"syndrome",
+	"resource",
+	"consumer",
+	"recently",
+	"notebook",
+	"superior",
+	"mobility",
+	"pipeline",
+	"accuracy",
+	"audience",
+	"persuade",
+	"equality",
+	"included",
+	"absolute",
+	"leverage",
+	"overhead",
+	"invasion",
+	"consider",
+	"surgical",
+	"priority",
+	"employee",
+	"somebody",
+	"merchant",
+	"dominant",
+	"indirect",
+	"concrete",
+	"argument",
+	"property",
+	"meantime",
+	"survival",
+	"violence",
+	"regional",
+	"assuming",
+	"keyboard",
+	"deferred",
+	"bulletin",
+	"aircraft",
+	"princess",
+	"shipping",
+	"clinical",
+	"election",
+	"disposal",
+	"operator",
+	"symbolic",
+	"district",
+	"champion",
+	"athletic",
+	"autonomy",
+	"required",
+	"romantic",
+	"mortgage",
+	"revision",
+	"disabled",
+	"portable",
+	"vicinity",
+	"disaster",
+	"calendar",
+	"research",
+	"fourteen",
+	"steadily",
+	"midnight",
+	"donation",
+	"contrast",
+	"publicly",
+	"daughter",
+	"informal",
+	"exchange",
+	"turnover",
+	"homepage",
+	"simplify",
+	"unlawful",
+	"yourself",
+	"thinking",
+	"parallel",
+	"advanced",
+	"official",
+	"movement",
+	"flexible",
+	"organize",
+	"modeling",
+	"interval",
+	"doubtful",
+	"tailored",
+	"suitable",
+	"weighted",
+	"intranet",
+	"director",
+	"periodic",
+	"acquired",
+	"protocol",
+	"purchase",
+	"ministry",
+	"creation",
+	"interest",
+	"engaging",
+	"attorney",
+	"tracking",
+	"evidence",
+	"foothill",
+	"adjacent",
+	"personal",
+	"marginal",
+	"duration",
+	"military",
+	"dropping",
+	"sequence",
+	"strength",
+	"clearing",
+	"featured",
+	"pleasant",
+	"definite",
+	"received",
+	"prospect",
+	"division",
+	"maintain",
+	"congress",
+	"previous",
+	"variable",
+	"casualty",
+	"reaction",
+	"software",
+	"external",
+	"standing",
+	"coverage",
+	"envelope",
+	"everyday",
+	"actually",
+	"shortage",
+	"sergeant",
+	"identify",
+	"petition",
+	"announce",
+	"dynamics",
+	"striking",
+	"painting",
+	"producer",
+	"anything",
+	"wherever",
+	"clothing",
+	"powerful",
+	"restrict",
+	"accurate",
+	"preserve",
+	"separate",
+	"heritage",
+	"response",
+	"positive",
+	"eventual",
+	"aluminum",
+	"weakness",
+	"approach",
+	"unlikely",
+	"generous",
+	"internal",
+	"landlord",
+	"judicial",
+	"reckless",
+	"sentence",
+	"feedback",
+	"maturity",
+	"junction",
+	"circular",
+	"momentum",
+	"probable",
+	"negative",
+	"daylight",
+	"optional",
+	"platform",
+	"monetary",
+	"guardian",
+	"recovery",
+	"profound",
+	"whatever",
+	"enormous",
+	"swimming",
+	"standard",
+	"shoulder",
+	"overseas",
+	"disorder",
+	"possible",
+	"touching",
+	"collapse",
+	"lifetime",
+	"distance",
+	"document",
+	"discount",
+	"multiple",
+	"decrease",
+	"opponent",
+	"corridor",
+	"solution",
+	"emphasis",
+	"sweeping",
+	"homeless",
+	"intimate",
+	"membrane",
+	"renowned",
+	"doctrine",
+	"proposal",
+	"identity",
+	"ultimate",
+	"endeavor",
+	"reporter",
+	"complete",
+	"business",
+	"northern",
+	"moderate",
+	"eighteen",
+	"creative",
+	"traveled",
+	"remember",
+	"building",
+	"appendix",
+	"register",
+	"overview",
+	"scrutiny",
+	"inspired",
+	"graduate",
+	"opposite",
+	"hospital",
+	"sensible",
+	"comprise",
+	"tendency",
+	"province",
+	"imperial",
+	"workshop",
+	"slightly",
+	"portrait",
+	"covering",
+	"formerly",
+	"physical",
+	"dramatic",
+	"although",
+	"specific",
+	"entrance",
+	"tactical",
+	"triangle",
+	"equation",
+	"aviation",
+	"reliance",
+	"efficacy",
+	"accident",
+	"cultural",
+	"function",
+	"estimate",
+	"diabetes",
+	"literary",
+	"occasion",
+	"schedule",
+	"boundary",
+	"indicate",
+	"floating",
+	"adequate",
+	"accepted",
+	"decision",
+	"progress",
+	"category",
+	"printing",
+	"anywhere",
+	"sampling",
+	"apparent",
+	"designer",
+	"southern",
+	"seasonal",
+	"graphics",
+	"involved",
+	"birthday",
+	"marriage",
+	"informed",
+	"withdraw",
+	"integral",
+	"sympathy",
+	"children",
+	"frontier",
+	"conclude",
+	"thorough",
+	"supposed",
+	"describe",
+	"customer",
+	"bacteria",
+	"parental",
+	"inherent",
+	"increase",
+	"exciting",
+	"convince",
+	"contract",
+	"struggle",
+	"advocate",
+	"artistic",
+	"volatile",
+	"limiting",
+	"academic",
+	"football",
+	"evaluate",
+	"familiar",
+	"provider",
+	"chemical",
+	"umbrella",
+	"takeover",
+	"explicit",
+	"probably",
+	"activity",
+	"criminal",
+	"strategy",
+	"original",
+	"training",
+	"valuable",
+	"magazine",
+	"computer",
+	"firewall",
+	"medicine",
+	"together",
+	"campaign",
+	"whenever",
+	"relation",
+	"breaking",
+	"educated",
+	"attached",
+	"offshore",
+	"bachelor",
+	"receiver",
+	"thousand",
+	"innocent",
+	"mounting",
+	"historic",
+	"commence",
+	"dialogue",
+	"industry",
+	"national",
+	"continue",
+	"somewhat",
+	"thirteen",
+	"religion",
+	"wildlife",
+	"emerging",
+	"confused",
+	"database",
+	"adjusted",
+	"terminal",
+	"constant",
+	"chairman",
+	"grateful",
+	"resident",
+	"contrary",
+	"humanity",
+	"rational",
+	"eligible",
+	"woodland",
+	"distinct",
+	"ideology",
+	"affected",
+	"tomorrow",
+	"repeated",
+	"exposure",
+	"electric",
+	"achieved",
+	"disclose",
+	"extended",
+	"delicate",
+	"minority",
+	"security",
+	"warranty",
+	"attitude",
+	"practice",
+	"majority",
+	"earnings",
+	"medieval",
+	"secondly",
+	"compound",
+	"engineer",
+	"currency",
+	"peaceful",
+	"location",
+	"universe",
+	"politics",
+	"situated",
+	"taxation",
+	"entirely",
+	"surprise",
+	"memorial",
+	"teaching",
+	"ordinary",
+	"scenario",
+	"conflict",
+	"colonial",
+	"incident",
+	"festival",
+	"moreover",
+	"economic",
+	"initiate",
+	"offering",
+	"reliable",
+	"interact",
+	"numerous",
+	"composed",
+	"crossing",
+	"quantity",
+	"learning",
+	"alliance",
+	"minimize",
+	"tangible",
+	"overcome",
+	"commerce",
+	"speaking",
+	"observer",
+	"deadline",
+	"material",
+	"position",
+	"likewise",
+	"bathroom",
+	"provided",
+	"directly",
+	"exercise",
+	"approval",
+	"pressing",
+	"capacity",
+	"frequent",
+	"stunning",
+	"patented",
+	"generate",
+	"assembly",
+	"sporting",
+	"breeding",
+	"dividend",
+	"pressure",
+	"ceremony",
+	"relative",
+	"friendly",
+	"isolated",
+	"highland",
+	"maximize",
+	"domestic",
+	"pursuant",
+	"addition",
+	"rigorous",
+	"diameter",
+	"language",
+	"becoming",
+	"hardware",
+	"suburban",
+	"tropical",
+	"wireless",
+	"nineteen",
+	"lighting",
+	"genocide",
+	"intended",
+	"laughter",
+	"interior",
+	"advisory",
+	"pleasure",
+	"handling",
+	"deciding",
+	"magnetic",
+	"spectrum",
+	"discover",
+	"colorful",
+	"baseball",
+	"analysis",
+	"question",
+	"critical",
+	"civilian",
+	"fraction",
+	"forecast",
+	"detailed",
+}
+
+// words accepted as 8 letter guesses on top of the answers, from the
+// sources listed in lists.go
+var extraWords8 = []string{
+	"aardvark",
+	"abandons",
+	"abetting",
+	"abnormal",
+	"abrasion",
+	"abrasive",
+	"abruptly",
+	"absentee",
+	"absently",
+	"absinthe",
+	"absorbed",
+	"abstract",
+	"absurdly",
+	"abundant",
+	"accessed",
+	"accounts",
+	"accustom",
+	"achieves",
+	"achiness",
+	"acquaint",
+	"acquires",
+	"acrobats",
+	"acronyms",
+	"activate",
+	"actively",
+	"activism",
+	"activist",
+	"adapters",
+	"additive",
+	"adhering",
+	"admiring",
+	"admitted",
+	"adopting",
+	"adoption",
+	"adorable",
+	"advances",
+	"advising",
+	"aeration",
+	"aerobics",
+	"affinity",
+	"affluent",
+	"afforded",
+	"aflutter",
+	"agencies",
+	"agitated",
+	"agnostic",
+	"agreeing",
+	"airborne",
+	"airlines",
+	"airports",
+	"airtight",
+	"alarming",
+	"alienate",
+	"alkaline",
+	"alkalize",
+	"allotted",
+	"allowing",
+	"almighty",
+	"alphabet",
+	"altering",
+	"altitude",
+	"amaretto",
+	"amateurs",
+	"ambiance",
+	"ambition",
+	"ambushed",
+	"amethyst",
+	"amicably",
+	"ammonium",
+	"amniotic",
+	"amperage",
+	"amusable",
+	"anaconda",
+	"analogue",
+	"analyses",
+	"analysts",
+	"analyzed",
+	"ancestor",
+	"ancestry",
+	"ancients",
+	"androids",
+	"aneurism",
+	"animator",
+	"annotate",
+	"annoying",
+	"annually",
+	"annulled",
+	"anointed",
+	"anointer",
+	"answered",
+	"anteater",
+	"antelope",
+	"antennae",
+	"antennas",
+	"antibody",
+	"antidote",
+	"antihero",
+	"antiques",
+	"antirust",
+	"anyplace",
+	"apostles",
+	"appalled",
+	"appeared",
+	"appetite",
+	"applause",
+	"applying",
+	"approved",
+	"approves",
+	"aptitude",
+	"aquarium",
+	"aqueduct",
+	"archives",
+	"ardently",
+	"arguable",
+	"arguably",
+	"armchair",
+	"arranged",
+	"arrested",
+	"arriving",
+	"arrogant",
+	"articles",
+	"artifact",
+	"ascended",
+	"aspirate",
+	"aspiring",
+	"assaults",
+	"assigned",
+	"assisted",
+	"assorted",
+	"astonish",
+	"atheists",
+	"atlantic",
+	"atonable",
+	"attacked",
+	"attacker",
+	"attempts",
+	"attended",
+	"attendee",
+	"attracts",
+	"atypical",
+	"auctions",
+	"audacity",
+	"audition",
+	"autistic",
+	"automate",
+	"avengers",
+	"avenging",
+	"averaged",
+	"averages",
+	"aversion",
+	"avocados",
+	"avoiding",
+	"awaiting",
+	"awakened",
+	"babbling",
+	"backache",
+	"backdrop",
+	"backfire",
+	"backhand",
+	"backlash",
+	"backless",
+	"backpack",
+	"backrest",
+	"backroom",
+	"backside",
+	"backslid",
+	"backspin",
+	"backstab",
+	"backtalk",
+	"backward",
+	"backwash",
+	"backyard",
+	"baffling",
+	"bagpipes",
+	"baguette",
+	"bakeries",
+	"bakeshop",
+	"balanced",
+	"balances",
+	"baldness",
+	"balloons",
+	"balsamic",
+	"bandages",
+	"banished",
+	"banister",
+	"bankable",
+	"bankbook",
+	"banknote",
+	"bankroll",
+	"bankrupt",
+	"barbecue",
+	"bargains",
+	"bargraph",
+	"baritone",
+	"barnacle",
+	"barracks",
+	"barrette",
+	"barriers",
+	"barstool",
+	"barterer",
+	"basilisk",
+	"bathrobe",
+	"battered",
+	"battling",
+	"bearings",
+	"beatings",
+	"beauties",
+	"bedrooms",
+	"bedstead",
+	"beetroot",
+	"beginner",
+	"behaving",
+	"behavior",
+	"believed",
+	"believer",
+	"believes",
+	"belonged",
+	"benefits",
+	"bermudas",
+	"betrayed",
+	"betrayer",
+	"beverage",
+	"bewilder",
+	"billions",
+	"binomial",
+	"birdbath",
+	"birthing",
+	"bitching",
+	"blackout",
+	"blankets",
+	"blasting",
+	"blatancy",
+	"bleached",
+	"bleeding",
+	"blenders",
+	"blending",
+	"blessing",
+	"blighted",
+	"blinders",
+	"blinding",
+	"blinking",
+	"blissful",
+	"blisters",
+	"blizzard",
+	"bloating",
+	"blockers",
+	"blocking",
+	"bloomers",
+	"blooming",
+	"blossoms",
+	"bluffing",
+	"blurting",
+	"blushing",
+	"blustery",
+	"boarding",
+	"boastful",
+	"boasting",
+	"boggling",
+	"bondless",
+	"bonehead",
+	"boneless",
+	"bonelike",
+	"bookcase",
+	"bookworm",
+	"boosters",
+	"bootlace",
+	"borrowed",
+	"borrower",
+	"botanist",
+	"bothered",
+	"bottling",
+	"boulders",
+	"bouncing",
+	"bounding",
+	"bounties",
+	"bouquets",
+	"boxerses",
+	"bracelet",
+	"bragging",
+	"branches",
+	"branding",
+	"breached",
+	"breakers",
+	"breakout",
+	"breathed",
+	"breather",
+	"breathes",
+	"breeches",
+	"brethren",
+	"briefing",
+	"brighter",
+	"brightly",
+	"bringing",
+	"broccoli",
+	"brochure",
+	"broiling",
+	"bronzing",
+	"brooding",
+	"brothers",
+	"browbeat",
+	"brownies",
+	"browsing",
+	"bruising",
+	"brunette",
+	"brushing",
+	"brussels",
+	"brutally",
+	"bubbling",
+	"buckshot",
+	"buckskin",
+	"buddhism",
+	"buddhist",
+	"buffalos",
+	"builders",
+	"bulldoze",
+	"bullfrog",
+	"bullhorn",
+	"bullring",
+	"bullseye",
+	"bullwhip",
+	"bullying",
+	"bungalow",
+	"bunkmate",
+	"burdened",
+	"burglars",
+	"bursting",
+	"busybody",
+	"butchers",
+	"buttered",
+	"buttoned",
+	"cabinets",
+	"cackling",
+	"cadillac",
+	"calamari",
+	"calamity",
+	"calculus",
+	"callback",
+	"calories",
+	"camisole",
+	"campfire",
+	"campsite",
+	"campuses",
+	"canceled",
+	"canister",
+	"cannabis",
+	"capitals",
+	"capsules",
+	"captains",
+	"captured",
+	"captures",
+	"caravans",
+	"cardigan",
+	"cardinal",
+	"careless",
+	"carmaker",
+	"carnival",
+	"carriers",
+	"carrying",
+	"cartload",
+	"cashiers",
+	"cassette",
+	"casually",
+	"catacomb",
+	"catalogs",
+	"catalyst",
+	"catalyze",
+	"catapult",
+	"cataract",
+	"catching",
+	"caterers",
+	"catering",
+	"catfight",
+	"cathouse",
+	"caucuses",
+	"cauldron",
+	"cautious",
+	"cavalier",
+	"ceilings",
+	"celibacy",
+	"celibate",
+	"cemetery",
+	"centaurs",
+	"centered",
+	"ceramics",
+	"cesarean",
+	"cesspool",
+	"chaffing",
+	"chambers",
+	"changing",
+	"channels",
+	"chanting",
+	"chaplain",
+	"chapters",
+	"charcoal",
+	"charging",
+	"charming",
+	"charting",
+	"chastise",
+	"chastity",
+	"chatroom",
+	"chatting",
+	"cheapest",
+	"cheating",
+	"checking",
+	"checkout",
+	"cheekses",
+	"cheering",
+	"chestnut",
+	"chewable",
+	"chickens",
+	"childish",
+	"chilling",
+	"chipmunk",
+	"chirping",
+	"chitchat",
+	"chivalry",
+	"chloride",
+	"chlorine",
+	"choosing",
+	"chopping",
+	"chowtime",
+	"chuckled",
+	"churches",
+	"churning",
+	"cilantro",
+	"cinnamon",
+	"circling",
+	"circuits",
+	"citation",
+	"citizens",
+	"claiming",
+	"clambake",
+	"clanking",
+	"clapping",
+	"clarinet",
+	"classics",
+	"classify",
+	"clavicle",
+	"cleaners",
+	"cleaning",
+	"cleansed",
+	"cleanser",
+	"clerical",
+	"cleverly",
+	"clicking",
+	"climates",
+	"climatic",
+	"climbers",
+	"climbing",
+	"clinging",
+	"clinking",
+	"clipping",
+	"clogging",
+	"closable",
+	"clothier",
+	"clouding",
+	"clubbing",
+	"clumsily",
+	"clusters",
+	"clutches",
+	"coaching",
+	"coalesce",
+	"coasters",
+	"coasting",
+	"coauthor",
+	"cockatoo",
+	"coconuts",
+	"coeditor",
+	"coercion",
+	"cogwheel",
+	"coherent",
+	"cohesive",
+	"coincide",
+	"coldness",
+	"coleslaw",
+	"coliseum",
+	"collects",
+	"colleges",
+	"colonies",
+	"colonist",
+	"colonize",
+	"coloring",
+	"colossal",
+	"coloured",
+	"combined",
+	"combines",
+	"comforts",
+	"commands",
+	"comments",
+	"commonly",
+	"compared",
+	"compares",
+	"competed",
+	"compiler",
+	"composer",
+	"compress",
+	"comrades",
+	"conceded",
+	"conceive",
+	"concepts",
+	"concerns",
+	"concerts",
+	"condense",
+	"confetti",
+	"confider",
+	"confined",
+	"confirms",
+	"confound",
+	"confront",
+	"confuses",
+	"congrats",
+	"conjured",
+	"conjuror",
+	"connects",
+	"conquers",
+	"conserve",
+	"consists",
+	"consumed",
+	"contacts",
+	"contains",
+	"contempt",
+	"contents",
+	"contests",
+	"contexts",
+	"contrite",
+	"controls",
+	"converse",
+	"converts",
+	"conveyed",
+	"cookbook",
+	"cookware",
+	"cornball",
+	"cornered",
+	"cornhusk",
+	"cornmeal",
+	"coronary",
+	"corporal",
+	"cosigner",
+	"cosmetic",
+	"costumes",
+	"coughing",
+	"councils",
+	"counters",
+	"counting",
+	"coupling",
+	"coursing",
+	"courtesy",
+	"courting",
+	"covenant",
+	"coveting",
+	"coziness",
+	"crabbing",
+	"crablike",
+	"crabmeat",
+	"crackers",
+	"cracking",
+	"cradling",
+	"craftily",
+	"cramping",
+	"cranking",
+	"crashing",
+	"cravings",
+	"crawfish",
+	"crawlers",
+	"crawling",
+	"crayfish",
+	"creasing",
+	"creating",
+	"creature",
+	"credenza",
+	"credible",
+	"credibly",
+	"credited",
+	"creepers",
+	"creeping",
+	"crescent",
+	"cresting",
+	"crewless",
+	"crewmate",
+	"crickets",
+	"cringing",
+	"crisping",
+	"criteria",
+	"critters",
+	"crockery",
+	"crossbow",
+	"crowding",
+	"crowning",
+	"cruelest",
+	"crumbled",
+	"crumbles",
+	"crumpled",
+	"cruncher",
+	"crusader",
+	"crushing",
+	"crutches",
+	"crystals",
+	"cucumber",
+	"cuddling",
+	"cufflink",
+	"culinary",
+	"culpable",
+	"cultured",
+	"cultures",
+	"cupboard",
+	"cupcakes",
+	"currents",
+	"curtains",
+	"cushions",
+	"cyclists",
+	"cylinder",
+	"daffodil",
+	"daintily",
+	"dallying",
+	"damaging",
+	"dandruff",
+	"dangling",
+	"daringly",
+	"darkened",
+	"darkness",
+	"darkroom",
+	"darlings",
+	"datebook",
+	"daunting",
+	"daybreak",
+	"daydream",
+	"dazzling",
+	"deafness",
+	"dealings",
+	"debating",
+	"debtless",
+	"decaying",
+	"deceased",
+	"deceived",
+	"deceiver",
+	"deceives",
+	"decipher",
+	"deckhand",
+	"declared",
+	"declares",
+	"declined",
+	"decorate",
+	"dedicate",
+	"deepness",
+	"defacing",
+	"defaults",
+	"defeated",
+	"defended",
+	"defender",
+	"defenses",
+	"deferral",
+	"defiance",
+	"deficits",
+	"defiling",
+	"defining",
+	"deflator",
+	"deforest",
+	"degraded",
+	"degrease",
+	"dejected",
+	"delaying",
+	"delegate",
+	"deleting",
+	"deletion",
+	"delicacy",
+	"delights",
+	"delirium",
+	"delivers",
+	"delusion",
+	"demanded",
+	"demeanor",
+	"democrat",
+	"demotion",
+	"deniable",
+	"dentists",
+	"departed",
+	"depended",
+	"depicted",
+	"depleted",
+	"deplored",
+	"deployed",
+	"deported",
+	"deposits",
+	"depraved",
+	"deprived",
+	"deputies",
+	"deputize",
+	"derailed",
+	"deranged",
+	"derelict",
+	"deserted",
+	"deserter",
+	"deserved",
+	"deserves",
+	"designed",
+	"deskpath",
+	"desktops",
+	"deskwork",
+	"desolate",
+	"despised",
+	"despises",
+	"desserts",
+	"destroys",
+	"destruct",
+	"detached",
+	"detected",
+	"detector",
+	"detonate",
+	"detoxify",
+	"develops",
+	"deviancy",
+	"deviated",
+	"deviator",
+	"devotion",
+	"devourer",
+	"devoutly",
+	"diabetic",
+	"diabolic",
+	"diagnose",
+	"diagonal",
+	"diagrams",
+	"dialects",
+	"diamonds",
+	"dictates",
+	"dictator",
+	"diffused",
+	"diffuser",
+	"dilation",
+	"diligent",
+	"diminish",
+	"dinosaur",
+	"diplomas",
+	"directed",
+	"direness",
+	"disables",
+	"disagree",
+	"disallow",
+	"disarray",
+	"disburse",
+	"discolor",
+	"diseased",
+	"diseases",
+	"disgrace",
+	"disguise",
+	"disliked",
+	"dislikes",
+	"dislodge",
+	"disloyal",
+	"dismount",
+	"dispatch",
+	"dispense",
+	"displace",
+	"displays",
+	"disposed",
+	"disprove",
+	"disputed",
+	"disputes",
+	"dissolve",
+	"dissuade",
+	"distaste",
+	"distract",
+	"distress",
+	"distrust",
+	"ditching",
+	"diverted",
+	"dividers",
+	"dividing",
+	"divinely",
+	"divinity",
+	"divisive",
+	"divorced",
+	"divorcee",
+	"divorces",
+	"doctored",
+	"doghouse",
+	"dolphins",
+	"domelike",
+	"dominate",
+	"dominion",
+	"dominoes",
+	"doorbell",
+	"doorknob",
+	"doornail",
+	"doorpost",
+	"doorstep",
+	"doorstop",
+	"doorways",
+	"doubling",
+	"doubting",
+	"download",
+	"downpour",
+	"downside",
+	"downward",
+	"drafting",
+	"dragging",
+	"dragster",
+	"drainage",
+	"draining",
+	"drawback",
+	"drawings",
+	"dreadful",
+	"dreading",
+	"dreamily",
+	"dreaming",
+	"drearily",
+	"drenched",
+	"dressers",
+	"drifting",
+	"drilling",
+	"drinkers",
+	"drinking",
+	"dripping",
+	"drivable",
+	"driveway",
+	"drooling",
+	"dropkick",
+	"drowning",
+	"drowsily",
+	"drugging",
+	"drumming",
+	"duckbill",
+	"duckling",
+	"ducktail",
+	"dullness",
+	"dumpling",
+	"dumpster",
+	"dungeons",
+	"dwelling",
+	"dynamite",
+	"dyslexia",
+	"dyslexic",
+	"earliest",
+	"earphone",
+	"earpiece",
+	"earplugs",
+	"earrings",
+	"easiness",
+	"eastward",
+	"edginess",
+	"educator",
+	"eggplant",
+	"eggshell",
+	"elective",
+	"elegance",
+	"elements",
+	"elephant",
+	"elevated",
+	"elevator",
+	"eligibly",
+	"elliptic",
+	"eloquent",
+	"embedded",
+	"embezzle",
+	"embolism",
+	"embraced",
+	"embraces",
+	"emission",
+	"emitting",
+	"emoticon",
+	"emotions",
+	"empathic",
+	"emphases",
+	"emphatic",
+	"employed",
+	"employer",
+	"emporium",
+	"emptying",
+	"enabling",
+	"enameled",
+	"encircle",
+	"enclosed",
+	"encoding",
+	"encroach",
+	"endanger",
+	"endeared",
+	"endpoint",
+	"enduring",
+	"energies",
+	"energize",
+	"enforced",
+	"enforcer",
+	"enforces",
+	"engraved",
+	"engraver",
+	"enhanced",
+	"enhances",
+	"enjoying",
+	"enlarged",
+	"enlisted",
+	"enquirer",
+	"enriched",
+	"enrolled",
+	"enslaved",
+	"entering",
+	"enticing",
+	"entirety",
+	"entities",
+	"entitled",
+	"entitles",
+	"entrench",
+	"entryway",
+	"enviable",
+	"enviably",
+	"envision",
+	"epidemic",
+	"epidural",
+	"epilepsy",
+	"epilogue",
+	"epiphany",
+	"episodes",
+	"equipped",
+	"erasable",
+	"escalate",
+	"escapade",
+	"escaping",
+	"escapist",
+	"escargot",
+	"esoteric",
+	"espresso",
+	"esteemed",
+	"estrogen",
+	"eternity",
+	"evacuate",
+	"evenings",
+	"eviction",
+	"evolving",
+	"examined",
+	"examiner",
+	"examples",
+	"excavate",
+	"exceeded",
+	"excerpts",
+	"excluded",
+	"excludes",
+	"executed",
+	"executes",
+	"exhibits",
+	"existent",
+	"existing",
+	"exorcism",
+	"exorcist",
+	"expanded",
+	"expected",
+	"expelled",
+	"expenses",
+	"expiring",
+	"explains",
+	"exploded",
+	"explodes",
+	"exploits",
+	"explored",
+	"explorer",
+	"exponent",
+	"exporter",
+	"exposing",
+	"exterior",
+	"extremes",
+	"eyebrows",
+	"fabulous",
+	"facedown",
+	"faceless",
+	"facelift",
+	"factions",
+	"failures",
+	"faintest",
+	"fainting",
+	"fairness",
+	"faithful",
+	"fallback",
+	"families",
+	"famished",
+	"famously",
+	"fanatics",
+	"farewell",
+	"farmland",
+	"farthest",
+	"fashions",
+	"fastball",
+	"fastness",
+	"fathered",
+	"fatherly",
+	"favoring",
+	"favorite",
+	"fearless",
+	"feasible",
+	"feathery",
+	"features",
+	"feelings",
+	"feminine",
+	"feminism",
+	"feminist",
+	"feminize",
+	"fernlike",
+	"ferocity",
+	"fetching",
+	"fictions",
+	"fiddling",
+	"fidelity",
+	"fielding",
+	"fiercely",
+	"fiftieth",
+	"fighters",
+	"fighting",
+	"figurine",
+	"figuring",
+	"filtered",
+	"filtrate",
+	"finalist",
+	"finalize",
+	"financed",
+	"finances",
+	"findings",
+	"fineness",
+	"fingered",
+	"finisher",
+	"finishes",
+	"firework",
+	"firmware",
+	"fiscally",
+	"fixation",
+	"fixtures",
+	"flagpole",
+	"flagship",
+	"flailing",
+	"flamingo",
+	"flanking",
+	"flannels",
+	"flapping",
+	"flashily",
+	"flashing",
+	"flatfoot",
+	"flatness",
+	"flattery",
+	"flatware",
+	"flatworm",
+	"flavored",
+	"flavours",
+	"flaxseed",
+	"fleeting",
+	"flinched",
+	"flipping",
+	"flipside",
+	"flirting",
+	"flogging",
+	"flooding",
+	"floodlit",
+	"flossing",
+	"flounder",
+	"flunking",
+	"flushing",
+	"flypaper",
+	"focusing",
+	"folklore",
+	"follicle",
+	"followed",
+	"followup",
+	"fondling",
+	"fondness",
+	"footbath",
+	"footgear",
+	"foothold",
+	"footless",
+	"footnote",
+	"footpath",
+	"footrest",
+	"footsore",
+	"footwear",
+	"footwork",
+	"forcibly",
+	"foremost",
+	"forgives",
+	"formally",
+	"formulas",
+	"fortunes",
+	"forwards",
+	"founding",
+	"fountain",
+	"fracking",
+	"fracture",
+	"fragment",
+	"fragrant",
+	"freaking",
+	"freckled",
+	"freckles",
+	"freebase",
+	"freedoms",
+	"freefall",
+	"freehand",
+	"freeload",
+	"freeness",
+	"freeware",
+	"freeways",
+	"freewill",
+	"freezers",
+	"freezing",
+	"frenzied",
+	"friction",
+	"frighten",
+	"frigidly",
+	"fronting",
+	"frostbit",
+	"frostily",
+	"frosting",
+	"fructose",
+	"frugally",
+	"furthest",
+	"galaxies",
+	"galleria",
+	"gambling",
+	"gangrene",
+	"gardener",
+	"gargoyle",
+	"garments",
+	"gathered",
+	"gatherer",
+	"gauntlet",
+	"gemstone",
+	"generals",
+	"genetics",
+	"geologic",
+	"geometry",
+	"geranium",
+	"germless",
+	"gestures",
+	"gigabyte",
+	"gigantic",
+	"giggling",
+	"gimmicks",
+	"gingerly",
+	"giraffes",
+	"giveaway",
+	"glancing",
+	"glaucoma",
+	"gleaming",
+	"glitched",
+	"glitches",
+	"gloating",
+	"globally",
+	"gloomily",
+	"glorious",
+	"gloveses",
+	"glowworm",
+	"goatskin",
+	"goldfish",
+	"goldmine",
+	"goodness",
+	"goodwill",
+	"goofball",
+	"gorgeous",
+	"gorillas",
+	"governed",
+	"governor",
+	"grabbing",
+	"graceful",
+	"gracious",
+	"gradient",
+	"graffiti",
+	"grafting",
+	"grainses",
+	"granddad",
+	"grandkid",
+	"grandson",
+	"granting",
+	"granular",
+	"grapeses",
+	"grasping",
+	"gratuity",
+	"greasily",
+	"greatest",
+	"greedily",
+	"greeting",
+	"griddles",
+	"griefing",
+	"grieving",
+	"grievous",
+	"grilling",
+	"grinding",
+	"grinning",
+	"grizzled",
+	"groggily",
+	"grooming",
+	"grooving",
+	"grounded",
+	"growling",
+	"grubbing",
+	"grudging",
+	"grueling",
+	"grumpily",
+	"grunting",
+	"guarding",
+	"guessing",
+	"guidable",
+	"gullible",
+	"gumballs",
+	"gurgling",
+	"gyration",
+	"habitant",
+	"habitual",
+	"hairline",
+	"hammered",
+	"hamsters",
+	"handball",
+	"handbook",
+	"handcart",
+	"handclap",
+	"handcuff",
+	"handedly",
+	"handgrip",
+	"handheld",
+	"handmade",
+	"handpick",
+	"handrail",
+	"handsome",
+	"handwash",
+	"handwork",
+	"handyman",
+	"hangnail",
+	"hangover",
+	"happened",
+	"happiest",
+	"hardcopy",
+	"hardcore",
+	"harddisk",
+	"hardened",
+	"hardener",
+	"hardhead",
+	"hardness",
+	"hardship",
+	"hardwood",
+	"harmless",
+	"harmonic",
+	"harvests",
+	"hassling",
+	"hatchery",
+	"hatching",
+	"haunting",
+	"haystack",
+	"hazelnut",
+	"haziness",
+	"headache",
+	"headband",
+	"headgear",
+	"headlamp",
+	"headless",
+	"headline",
+	"headlock",
+	"headrest",
+	"headroom",
+	"headsets",
+	"headsman",
+	"headwear",
+	"hearings",
+	"heaviest",
+	"hedgehog",
+	"helpless",
+	"helpline",
+	"henchman",
+	"hesitant",
+	"hesitate",
+	"hexagram",
+	"highness",
+	"highways",
+	"hijacked",
+	"hitching",
+	"hoarding",
+	"holdings",
+	"holidays",
+	"homemade",
+	"honestly",
+	"honeybee",
+	"honoring",
+	"honoured",
+	"hopeless",
+	"horizons",
+	"horrible",
+	"horribly",
+	"hounding",
+	"hovering",
+	"huddling",
+	"humbling",
+	"humility",
+	"humoring",
+	"humorist",
+	"humorous",
+	"humpback",
+	"hundreds",
+	"hungrily",
+	"huntress",
+	"huntsman",
+	"hurrying",
+	"husbands",
+	"hydrated",
+	"hydrogen",
+	"hypnoses",
+	"hypnosis",
+	"hypnotic",
+	"icebergs",
+	"idealism",
+	"idealist",
+	"idealize",
+	"igniting",
+	"ignition",
+	"ignoring",
+	"illegals",
+	"illusion",
+	"illusive",
+	"imagined",
+	"imagines",
+	"imbecile",
+	"imitated",
+	"imitates",
+	"immature",
+	"imminent",
+	"immobile",
+	"immodest",
+	"immortal",
+	"immunity",
+	"immunize",
+	"impaired",
+	"impeding",
+	"implants",
+	"implicit",
+	"implying",
+	"impolite",
+	"imported",
+	"importer",
+	"imposing",
+	"impotent",
+	"imprison",
+	"improper",
+	"improved",
+	"improves",
+	"impulses",
+	"impurity",
+	"inclined",
+	"includes",
+	"incoming",
+	"indulges",
+	"infamous",
+	"inferior",
+	"inferred",
+	"infinite",
+	"inflated",
+	"ingested",
+	"initials",
+	"injected",
+	"injuries",
+	"inkblots",
+	"inquires",
+	"insanely",
+	"insecure",
+	"inserted",
+	"insights",
+	"insisted",
+	"insomnia",
+	"inspects",
+	"installs",
+	"instruct",
+	"internet",
+	"interred",
+	"invasive",
+	"invented",
+	"inverted",
+	"invested",
+	"inviting",
+	"involves",
+	"irrigate",
+	"irritant",
+	"irritate",
+	"islamist",
+	"jailbird",
+	"jalapeno",
+	"jaundice",
+	"jealousy",
+	"jingling",
+	"jokester",
+	"jokingly",
+	"journals",
+	"journeys",
+	"joyfully",
+	"joystick",
+	"jubilant",
+	"juggling",
+	"juncture",
+	"jungling",
+	"junkyard",
+	"justices",
+	"justness",
+	"juvenile",
+	"kangaroo",
+	"keenness",
+	"keepsake",
+	"kerchief",
+	"kerosene",
+	"keychain",
+	"keystone",
+	"killings",
+	"kilobyte",
+	"kilogram",
+	"kilowatt",
+	"kindling",
+	"kindness",
+	"kingdoms",
+	"kissable",
+	"kitchens",
+	"knapsack",
+	"kneeling",
+	"knickers",
+	"knitting",
+	"knocking",
+	"labelled",
+	"laborers",
+	"laboring",
+	"labrador",
+	"ladybird",
+	"ladylike",
+	"lakeside",
+	"landfall",
+	"landfill",
+	"landings",
+	"landlady",
+	"landless",
+	"landline",
+	"landmark",
+	"landmass",
+	"landmine",
+	"landside",
+	"lanterns",
+	"latitude",
+	"latticed",
+	"laughing",
+	"launched",
+	"launcher",
+	"launches",
+	"lavender",
+	"lawfully",
+	"laxative",
+	"laziness",
+	"lecturer",
+	"leftover",
+	"leggings",
+	"lemonade",
+	"leniency",
+	"lethargy",
+	"leveling",
+	"levitate",
+	"liberals",
+	"licensed",
+	"licenses",
+	"licorice",
+	"lifeboat",
+	"lifespan",
+	"ligament",
+	"lightens",
+	"lighters",
+	"likeness",
+	"limpness",
+	"linguini",
+	"linguist",
+	"linoleum",
+	"listened",
+	"listener",
+	"listings",
+	"litigate",
+	"littlest",
+	"loathing",
+	"lobsters",
+	"locating",
+	"lollipop",
+	"loosened",
+	"lopsided",
+	"lounging",
+	"lowering",
+	"luckless",
+	"luggages",
+	"lukewarm",
+	"luminous",
+	"lunchbox",
+	"luncheon",
+	"lushness",
+	"lustrous",
+	"luxuries",
+	"luxuties",
+	"lyricism",
+	"lyricist",
+	"macarena",
+	"macaroni",
+	"machines",
+	"magician",
+	"magnolia",
+	"mahogany",
+	"majestic",
+	"makeover",
+	"managers",
+	"managing",
+	"mandarin",
+	"mandates",
+	"mandolin",
+	"manicure",
+	"manifest",
+	"mannered",
+	"manpower",
+	"manually",
+	"marathon",
+	"marbling",
+	"marching",
+	"marigold",
+	"mariners",
+	"maritime",
+	"marketed",
+	"markings",
+	"marrying",
+	"marshals",
+	"marzipan",
+	"massaged",
+	"massager",
+	"massages",
+	"mastered",
+	"matchbox",
+	"matching",
+	"maternal",
+	"mattered",
+	"maturely",
+	"maturing",
+	"maverick",
+	"meanings",
+	"measures",
+	"meatball",
+	"mechanic",
+	"meetings",
+	"mellowed",
+	"memories",
+	"memorize",
+	"menacing",
+	"mentally",
+	"mentions",
+	"messaged",
+	"messages",
+	"metaphor",
+	"migrants",
+	"millions",
+	"minerals",
+	"mingling",
+	"minimums",
+	"mirrored",
+	"mischief",
+	"missions",
+	"misspell",
+	"mistaken",
+	"mistakes",
+	"mobilize",
+	"mobsters",
+	"modified",
+	"moisture",
+	"molasses",
+	"molecule",
+	"molehill",
+	"monetize",
+	"mongoose",
+	"monitors",
+	"monkhood",
+	"monogamy",
+	"monogram",
+	"monopoly",
+	"monorail",
+	"monotone",
+	"monotype",
+	"monoxide",
+	"monsieur",
+	"monument",
+	"moonbeam",
+	"moonlike",
+	"moonrise",
+	"moonwalk",
+	"morality",
+	"morbidly",
+	"mornings",
+	"morphine",
+	"morphing",
+	"mortally",
+	"mortuary",
+	"mosquito",
+	"mothball",
+	"motherly",
+	"motivate",
+	"mournful",
+	"mourning",
+	"mouthing",
+	"mudslide",
+	"mulberry",
+	"multiply",
+	"mumbling",
+	"munchkin",
+	"murdered",
+	"murderer",
+	"muscular",
+	"mushroom",
+	"musician",
+	"mutation",
+	"mutually",
+	"nameless",
+	"narrowed",
+	"natively",
+	"nativity",
+	"naturist",
+	"nautical",
+	"navigate",
+	"nearness",
+	"neatness",
+	"necklace",
+	"needless",
+	"negation",
+	"negligee",
+	"neighbor",
+	"networks",
+	"neurosis",
+	"neurotic",
+	"neutered",
+	"newfound",
+	"nibbling",
+	"nickname",
+	"nicotine",
+	"nightcap",
+	"nobodies",
+	"nocturne",
+	"nominate",
+	"nonsense",
+	"normally",
+	"nostrils",
+	"nothings",
+	"noticing",
+	"notified",
+	"nowadays",
+	"nuisance",
+	"numbered",
+	"numbness",
+	"numerate",
+	"nuptials",
+	"nurtured",
+	"nutrient",
+	"nutshell",
+	"obedient",
+	"obituary",
+	"obligate",
+	"oblivion",
+	"observed",
+	"obsessed",
+	"obsolete",
+	"obstacle",
+	"obstruct",
+	"obtained",
+	"occupant",
+	"occupied",
+	"occupier",
+	"occurred",
+	"offended",
+	"offender",
+	"offenses",
+	"officers",
+	"ointment",
+	"oleander",
+	"olympics",
+	"omelette",
+	"omission",
+	"omitting",
+	"omnivore",
+	"oncoming",
+	"onlooker",
+	"onscreen",
+	"openings",
+	"openness",
+	"operable",
+	"operated",
+	"operates",
+	"opinions",
+	"opposing",
+	"optimize",
+	"orchards",
+	"ordering",
+	"oriented",
+	"outboard",
+	"outbound",
+	"outbreak",
+	"outburst",
+	"outcasts",
+	"outclass",
+	"outdated",
+	"outdoors",
+	"outfield",
+	"outflank",
+	"outgoing",
+	"outhouse",
+	"outlines",
+	"outlying",
+	"outmatch",
+	"outraged",
+	"outreach",
+	"outright",
+	"outscore",
+	"outshine",
+	"outshoot",
+	"outsider",
+	"outsmart",
+	"outtakes",
+	"outthink",
+	"outweigh",
+	"overarch",
+	"overbill",
+	"overbite",
+	"overbook",
+	"overcast",
+	"overcoat",
+	"overcook",
+	"overfeed",
+	"overfill",
+	"overflow",
+	"overfull",
+	"overhand",
+	"overhang",
+	"overhaul",
+	"overhear",
+	"overheat",
+	"overhung",
+	"overkill",
+	"overlaid",
+	"overload",
+	"overlook",
+	"overlord",
+	"overpass",
+	"overplay",
+	"overrate",
+	"override",
+	"overripe",
+	"overrule",
+	"overshot",
+	"oversold",
+	"overstay",
+	"overstep",
+	"overtake",
+	"overtime",
+	"overtone",
+	"overture",
+	"overturn",
+	"oxymoron",
+	"pacifier",
+	"pacifism",
+	"pacifist",
+	"packaged",
+	"packages",
+	"paddling",
+	"painless",
+	"painters",
+	"palpable",
+	"pampered",
+	"pamperer",
+	"pamphlet",
+	"pancakes",
+	"pancreas",
+	"pandemic",
+	"panicked",
+	"panorama",
+	"panthers",
+	"parabola",
+	"paradigm",
+	"parading",
+	"parakeet",
+	"paralyze",
+	"paranoia",
+	"paranoid",
+	"parasail",
+	"parasite",
+	"pardoned",
+	"parfumes",
+	"parmesan",
+	"partners",
+	"partying",
+	"passable",
+	"passably",
+	"passages",
+	"passcode",
+	"passerby",
+	"passions",
+	"passives",
+	"passover",
+	"passport",
+	"password",
+	"pastrami",
+	"pastries",
+	"pastures",
+	"patching",
+	"paternal",
+	"pathways",
+	"patients",
+	"patriots",
+	"patterns",
+	"pavement",
+	"pavilion",
+	"paycheck",
+	"payments",
+	"payphone",
+	"peacocks",
+	"peculiar",
+	"pedantic",
+	"peddling",
+	"pedicure",
+	"pedigree",
+	"pegboard",
+	"penalize",
+	"penguins",
+	"penknife",
+	"pentagon",
+	"peppered",
+	"perceive",
+	"perjurer",
+	"peroxide",
+	"persists",
+	"pertains",
+	"pharmacy",
+	"phrasing",
+	"pickings",
+	"pictured",
+	"pictures",
+	"piercing",
+	"pilgrims",
+	"piloting",
+	"pinching",
+	"pinecone",
+	"pinpoint",
+	"pinwheel",
+	"pioneers",
+	"pitchers",
+	"pitching",
+	"placidly",
+	"planners",
+	"planning",
+	"planting",
+	"plastics",
+	"platinum",
+	"platonic",
+	"platypus",
+	"playable",
+	"playback",
+	"playlist",
+	"playmate",
+	"playoffs",
+	"playroom",
+	"playtime",
+	"pleading",
+	"pleasing",
+	"pledging",
+	"plethora",
+	"plotting",
+	"plumbing",
+	"plunging",
+	"poaching",
+	"pointers",
+	"pointing",
+	"poisoned",
+	"policies",
+	"polished",
+	"polishes",
+	"politely",
+	"pollutes",
+	"popcorns",
+	"popsicle",
+	"populace",
+	"populate",
+	"porridge",
+	"porthole",
+	"portions",
+	"portside",
+	"possibly",
+	"postcard",
+	"postpone",
+	"potatoes",
+	"pouncing",
+	"powdered",
+	"prairies",
+	"praising",
+	"prancing",
+	"prankish",
+	"preacher",
+	"preamble",
+	"preceded",
+	"precedes",
+	"precinct",
+	"preclude",
+	"predator",
+	"pregnant",
+	"premiere",
+	"premises",
+	"premiums",
+	"prenatal",
+	"preorder",
+	"prepared",
+	"prepares",
+	"presence",
+	"presents",
+	"presumed",
+	"pretends",
+	"pretense",
+	"pretzels",
+	"prevents",
+	"previews",
+	"prideful",
+	"printers",
+	"printout",
+	"prisoner",
+	"pristine",
+	"privates",
+	"problems",
+	"proceeds",
+	"proclaim",
+	"procurer",
+	"prodding",
+	"prodigal",
+	"produced",
+	"produces",
+	"products",
+	"profiles",
+	"programs",
+	"prohibit",
+	"projects",
+	"prologue",
+	"promised",
+	"promises",
+	"promoted",
+	"promoter",
+	"promotes",
+	"prompted",
+	"prompter",
+	"promptly",
+	"pronouns",
+	"proofing",
+	"properly",
+	"proposed",
+	"proposes",
+	"prostate",
+	"protects",
+	"protegee",
+	"proteins",
+	"protests",
+	"protract",
+	"protrude",
+	"proudest",
+	"provable",
+	"provides",
+	"provoked",
+	"prowling",
+	"psychics",
+	"pumpkins",
+	"punching",
+	"punctual",
+	"punisher",
+	"purebred",
+	"pureness",
+	"purifier",
+	"purplish",
+	"purposes",
+	"pursuing",
+	"pursuits",
+	"purveyor",
+	"pushcart",
+	"pushover",
+	"puzzling",
+	"pyramids",
+	"quadrant",
+	"quagmire",
+	"quaintly",
+	"quarrels",
+	"quarters",
+	"quickest",
+	"quitting",
+	"quotable",
+	"radiance",
+	"radiated",
+	"radiator",
+	"radicals",
+	"railroad",
+	"rainbows",
+	"raindrop",
+	"rambling",
+	"randomly",
+	"rattling",
+	"reabsorb",
+	"reaching",
+	"reacting",
+	"reactive",
+	"readings",
+	"reaffirm",
+	"realized",
+	"realizes",
+	"reappear",
+	"rearview",
+	"reasoned",
+	"reassign",
+	"reassure",
+	"reattach",
+	"rebounds",
+	"reburial",
+	"rebuttal",
+	"recalled",
+	"receding",
+	"receipts",
+	"receives",
+	"reciting",
+	"recliner",
+	"reclines",
+	"recorded",
+	"recorder",
+	"recourse",
+	"recovers",
+	"recreate",
+	"recycled",
+	"recycler",
+	"redefine",
+	"redesign",
+	"redirect",
+	"reducing",
+	"redwoods",
+	"reemerge",
+	"referees",
+	"referral",
+	"referred",
+	"refinery",
+	"refining",
+	"refinish",
+	"reflects",
+	"reflexes",
+	"reforest",
+	"reformat",
+	"reformed",
+	"reformer",
+	"refreeze",
+	"refusing",
+	"regained",
+	"regarded",
+	"regiment",
+	"registry",
+	"regulars",
+	"regulate",
+	"reigning",
+	"reindeer",
+	"rejected",
+	"rekindle",
+	"relapsed",
+	"relating",
+	"relaxing",
+	"released",
+	"releases",
+	"reliably",
+	"relieved",
+	"reliever",
+	"reliving",
+	"reloaded",
+	"relocate",
+	"remained",
+	"remedial",
+	"reminded",
+	"reminder",
+	"remnants",
+	"remotely",
+	"removing",
+	"rendered",
+	"renderer",
+	"renegade",
+	"renewing",
+	"renounce",
+	"renovate",
+	"rentable",
+	"reoccupy",
+	"reopened",
+	"repaired",
+	"repaying",
+	"repealed",
+	"repeater",
+	"rephrase",
+	"replaced",
+	"replaces",
+	"replayed",
+	"reported",
+	"reposted",
+	"reproach",
+	"reptiles",
+	"requests",
+	"requires",
+	"resample",
+	"rescuing",
+	"reselect",
+	"reseller",
+	"resemble",
+	"resented",
+	"reserved",
+	"reserves",
+	"residing",
+	"residual",
+	"resigned",
+	"resolute",
+	"resolved",
+	"resolves",
+	"resonant",
+	"resonate",
+	"respects",
+	"responds",
+	"restored",
+	"resubmit",
+	"resulted",
+	"resupply",
+	"retailer",
+	"retained",
+	"retainer",
+	"retarded",
+	"retiring",
+	"retorted",
+	"retrieve",
+	"returned",
+	"reunions",
+	"reunited",
+	"reusable",
+	"revealed",
+	"revenues",
+	"reverend",
+	"reversal",
+	"reversed",
+	"reviewed",
+	"reviewer",
+	"reviving",
+	"revolver",
+	"revolves",
+	"rewarded",
+	"reworked",
+	"richeses",
+	"richness",
+	"riddance",
+	"ripeness",
+	"ripening",
+	"rippling",
+	"riverbed",
+	"riveting",
+	"roasting",
+	"robotics",
+	"rockband",
+	"rockfish",
+	"rocklike",
+	"rockstar",
+	"rosemary",
+	"rotation",
+	"roughing",
+	"roulette",
+	"rounding",
+	"roundish",
+	"routines",
+	"rudeness",
+	"rumbling",
+	"ruptured",
+	"sabotage",
+	"saddened",
+	"saddlery",
+	"saddling",
+	"safeness",
+	"sailboat",
+	"salaried",
+	"salaries",
+	"salutary",
+	"sanction",
+	"sanctity",
+	"sandbank",
+	"sandfish",
+	"sandwich",
+	"sandworm",
+	"sanitary",
+	"sapphire",
+	"sardines",
+	"satiable",
+	"saturate",
+	"sausages",
+	"scalable",
+	"scalding",
+	"scallion",
+	"scalping",
+	"scamming",
+	"scandals",
+	"scanners",
+	"scanning",
+	"scarcely",
+	"scarcity",
+	"scarring",
+	"scheming",
+	"schnapps",
+	"scholars",
+	"schooled",
+	"sciences",
+	"scissors",
+	"scolding",
+	"scooters",
+	"scorched",
+	"scorpion",
+	"scouring",
+	"scouting",
+	"scowling",
+	"scrabble",
+	"scraggly",
+	"scraping",
+	"screamed",
+	"screwing",
+	"scribble",
+	"scribing",
+	"scripted",
+	"scrolled",
+	"scrubbed",
+	"scrubber",
+	"sculptor",
+	"seagulls",
+	"seahorse",
+	"searched",
+	"searches",
+	"seashell",
+	"seasoned",
+	"secluded",
+	"secretly",
+	"sections",
+	"securely",
+	"securing",
+	"sedation",
+	"sedative",
+	"sediment",
+	"seducing",
+	"segments",
+	"selected",
+	"selector",
+	"semantic",
+	"semester",
+	"seminars",
+	"semisoft",
+	"senators",
+	"senorita",
+	"sensuous",
+	"serrated",
+	"services",
+	"sessions",
+	"setbacks",
+	"settings",
+	"settling",
+	"severely",
+	"severity",
+	"shacking",
+	"shakable",
+	"shampoos",
+	"shamrock",
+	"sharpest",
+	"shelling",
+	"shelters",
+	"shelving",
+	"shielded",
+	"shifting",
+	"shipyard",
+	"shocking",
+	"shooters",
+	"shooting",
+	"shoplift",
+	"shoppers",
+	"shopping",
+	"shoptalk",
+	"shortcut",
+	"shortest",
+	"shortses",
+	"shouting",
+	"showcase",
+	"showdown",
+	"showered",
+	"showgirl",
+	"showroom",
+	"shrapnel",
+	"shredded",
+	"shredder",
+	"shrewdly",
+	"shrouded",
+	"shucking",
+	"shutdown",
+	"shutting",
+	"siberian",
+	"siblings",
+	"sickness",
+	"sidewalk",
+	"sideways",
+	"sighting",
+	"silenced",
+	"silencer",
+	"silently",
+	"simplest",
+	"simulate",
+	"singular",
+	"sinister",
+	"sisterly",
+	"sixtieth",
+	"sizeable",
+	"sizzling",
+	"skeeters",
+	"skeletal",
+	"skeleton",
+	"skeptics",
+	"sketches",
+	"skillful",
+	"skimming",
+	"skimpily",
+	"skincare",
+	"skinhead",
+	"skinless",
+	"skinning",
+	"skipping",
+	"skirmish",
+	"skulking",
+	"skydiver",
+	"skylight",
+	"slacking",
+	"slamming",
+	"slapping",
+	"slashing",
+	"sledding",
+	"sleeping",
+	"slighted",
+	"slimness",
+	"slinging",
+	"slippers",
+	"slipping",
+	"slobbery",
+	"sloppily",
+	"slumming",
+	"smacking",
+	"smallest",
+	"smallpox",
+	"smartest",
+	"smashing",
+	"smelling",
+	"smelting",
+	"smoother",
+	"smoothly",
+	"smuggler",
+	"smugness",
+	"snapping",
+	"sneaking",
+	"sneezing",
+	"snippets",
+	"snipping",
+	"snooping",
+	"snowball",
+	"snowbird",
+	"snowdrop",
+	"snowfall",
+	"snowless",
+	"snowmans",
+	"snowplow",
+	"snowshoe",
+	"snowsuit",
+	"snugness",
+	"socially",
+	"softened",
+	"softener",
+	"soldiers",
+	"solitude",
+	"someones",
+	"sometime",
+	"songbird",
+	"soothing",
+	"sopranos",
+	"sounding",
+	"spacious",
+	"sparkler",
+	"sparring",
+	"sparrows",
+	"spawning",
+	"spearman",
+	"specials",
+	"specimen",
+	"speckled",
+	"speeches",
+	"speeding",
+	"spelling",
+	"spending",
+	"spilling",
+	"spinning",
+	"spinster",
+	"spirited",
+	"spitting",
+	"splashed",
+	"splatter",
+	"splendid",
+	"splendor",
+	"splicing",
+	"splinter",
+	"splitter",
+	"splotchy",
+	"spoilage",
+	"spoilers",
+	"spoiling",
+	"sponsors",
+	"spookily",
+	"spotless",
+	"spotting",
+	"spouting",
+	"sprained",
+	"spraying",
+	"sprinkle",
+	"spyglass",
+	"squabble",
+	"squander",
+	"squarely",
+	"squashed",
+	"squatted",
+	"squatter",
+	"squealer",
+	"squeegee",
+	"squeezed",
+	"squiggle",
+	"squiggly",
+	"squirrel",
+	"stabbing",
+	"stacking",
+	"stadiums",
+	"staffers",
+	"staggers",
+	"stagnant",
+	"stagnate",
+	"staining",
+	"stairses",
+	"stalkers",
+	"stalking",
+	"stalling",
+	"stallion",
+	"stapling",
+	"stardust",
+	"starfish",
+	"starless",
+	"starring",
+	"starship",
+	"starters",
+	"starting",
+	"startled",
+	"startups",
+	"starving",
+	"stations",
+	"statutes",
+	"steadier",
+	"stealing",
+	"steaming",
+	"steelers",
+	"steering",
+	"stepping",
+	"sterling",
+	"stickers",
+	"sticking",
+	"stifling",
+	"stimulus",
+	"stingily",
+	"stinging",
+	"stingray",
+	"stinking",
+	"stirring",
+	"stitched",
+	"stitches",
+	"stomachs",
+	"stomping",
+	"stoppage",
+	"stopping",
+	"storable",
+	"storming",
+	"stowaway",
+	"straddle",
+	"straight",
+	"strained",
+	"strainer",
+	"stranded",
+	"stranger",
+	"strangle",
+	"strapped",
+	"streamed",
+	"streamer",
+	"stressed",
+	"stresses",
+	"stricken",
+	"strictly",
+	"strikers",
+	"stripped",
+	"striving",
+	"stroller",
+	"stronger",
+	"strongly",
+	"strummer",
+	"stubborn",
+	"students",
+	"studying",
+	"stuffing",
+	"stumbled",
+	"sturdily",
+	"stylized",
+	"subduing",
+	"subfloor",
+	"subgroup",
+	"subjects",
+	"sublease",
+	"sublevel",
+	"submerge",
+	"subpanel",
+	"subprime",
+	"subsonic",
+	"subtitle",
+	"subtlety",
+	"subtotal",
+	"subtract",
+	"succeeds",
+	"suddenly",
+	"suffered",
+	"sufferer",
+	"suffrage",
+	"suggests",
+	"suitably",
+	"suitcase",
+	"sulphate",
+	"sunshine",
+	"superjet",
+	"superman",
+	"supermom",
+	"supplied",
+	"supplier",
+	"supplies",
+	"supports",
+	"supposes",
+	"suppress",
+	"sureness",
+	"surfaced",
+	"surfaces",
+	"surgeons",
+	"surround",
+	"survived",
+	"survives",
+	"survivor",
+	"suspects",
+	"suspense",
+	"swallows",
+	"swapping",
+	"swarming",
+	"swearing",
+	"sweaters",
+	"sweating",
+	"sweetest",
+	"swelling",
+	"swimmers",
+	"swimsuit",
+	"swimwear",
+	"swinging",
+	"swirling",
+	"switched",
+	"switches",
+	"sycamore",
+	"symmetry",
+	"symphony",
+	"symptoms",
+	"synonyms",
+	"synopses",
+	"synopsis",
+	"tableful",
+	"tabloids",
+	"tackling",
+	"tactless",
+	"talented",
+	"talisman",
+	"tameness",
+	"tampered",
+	"tantrums",
+	"tapeless",
+	"tapering",
+	"tapestry",
+	"targeted",
+	"tartness",
+	"tattered",
+	"tattling",
+	"tattooed",
+	"taunting",
+	"teachers",
+	"teaspoon",
+	"teething",
+	"template",
+	"temporal",
+	"tempting",
+	"tensions",
+	"terrapin",
+	"terribly",
+	"thanking",
+	"thatched",
+	"theaters",
+	"theatres",
+	"theology",
+	"theories",
+	"theorize",
+	"thespian",
+	"thickens",
+	"thieving",
+	"thievish",
+	"thinkers",
+	"thinness",
+	"thinning",
+	"thirties",
+	"thoughts",
+	"threaded",
+	"threaten",
+	"thrilled",
+	"thriller",
+	"thriving",
+	"throttle",
+	"throwing",
+	"thumping",
+	"tidiness",
+	"tightens",
+	"tightwad",
+	"tingling",
+	"tinkling",
+	"tinsmith",
+	"toasting",
+	"tolerant",
+	"tolerate",
+	"tomatoes",
+	"torching",
+	"tortilla",
+	"tortoise",
+	"toughest",
+	"towering",
+	"trackers",
+	"traction",
+	"trailers",
+	"trailing",
+	"trainers",
+	"traitors",
+	"tranquil",
+	"transmit",
+	"trapdoor",
+	"trapping",
+	"trashing",
+	"traverse",
+	"travesty",
+	"treading",
+	"treaties",
+	"treating",
+	"treetops",
+	"trenches",
+	"trending",
+	"trespass",
+	"tribunal",
+	"trickery",
+	"trickily",
+	"tricking",
+	"tricolor",
+	"tricycle",
+	"triggers",
+	"trillion",
+	"trimming",
+	"trimness",
+	"trinkets",
+	"triplets",
+	"tripping",
+	"triumphs",
+	"trolling",
+	"trombone",
+	"troopers",
+	"trophies",
+	"troubled",
+	"troubles",
+	"trousers",
+	"truckers",
+	"truffles",
+	"trumpets",
+	"trustees",
+	"trustful",
+	"trusting",
+	"tubeless",
+	"tuesdays",
+	"tumbling",
+	"turbines",
+	"turbofan",
+	"turbojet",
+	"turmeric",
+	"tutorial",
+	"tutoring",
+	"tweaking",
+	"tweezers",
+	"twenties",
+	"twilight",
+	"twirling",
+	"twisting",
+	"unafraid",
+	"unbanned",
+	"unbeaten",
+	"unbiased",
+	"unbitten",
+	"unbolted",
+	"unbridle",
+	"unbroken",
+	"unbundle",
+	"unburned",
+	"unbutton",
+	"uncapped",
+	"uncaring",
+	"uncoated",
+	"uncoiled",
+	"uncombed",
+	"uncommon",
+	"uncooked",
+	"uncouple",
+	"uncurled",
+	"underage",
+	"underarm",
+	"undercut",
+	"underdog",
+	"underfed",
+	"underpay",
+	"undertow",
+	"underuse",
+	"underway",
+	"undocked",
+	"undusted",
+	"unearned",
+	"uneasily",
+	"unedited",
+	"unending",
+	"unenvied",
+	"unfairly",
+	"unfasten",
+	"unfilled",
+	"unfitted",
+	"unflawed",
+	"unframed",
+	"unfreeze",
+	"unfrozen",
+	"unfunded",
+	"unglazed",
+	"ungloved",
+	"ungraded",
+	"unguided",
+	"unharmed",
+	"unheated",
+	"unhidden",
+	"unicorns",
+	"unicycle",
+	"uniforms",
+	"uniquely",
+	"unissued",
+	"unjustly",
+	"unleaded",
+	"unlinked",
+	"unlisted",
+	"unloaded",
+	"unloader",
+	"unlocked",
+	"unlovely",
+	"unloving",
+	"unmanned",
+	"unmapped",
+	"unmarked",
+	"unmasked",
+	"unmolded",
+	"unmoving",
+	"unneeded",
+	"unopened",
+	"unpacked",
+	"unpadded",
+	"unpaired",
+	"unpeeled",
+	"unpicked",
+	"unpinned",
+	"unplowed",
+	"unproven",
+	"unranked",
+	"unrented",
+	"unrigged",
+	"unrushed",
+	"unsaddle",
+	"unsalted",
+	"unsavory",
+	"unsealed",
+	"unseated",
+	"unseeing",
+	"unseemly",
+	"unselect",
+	"unshaken",
+	"unshaved",
+	"unshaven",
+	"unsigned",
+	"unsliced",
+	"unsmooth",
+	"unsocial",
+	"unsoiled",
+	"unsolved",
+	"unsorted",
+	"unspoken",
+	"unstable",
+	"unsteady",
+	"unstitch",
+	"unsubtle",
+	"unsubtly",
+	"unsuited",
+	"untagged",
+	"untapped",
+	"untested",
+	"unthawed",
+	"unthread",
+	"untimely",
+	"untitled",
+	"unturned",
+	"unusable",
+	"unvalued",
+	"unvaried",
+	"unveiled",
+	"unvented",
+	"unviable",
+	"unwanted",
+	"unwashed",
+	"unwieldy",
+	"unworthy",
+	"upcoming",
+	"upgraded",
+	"upgrades",
+	"upheaval",
+	"uplifted",
+	"uprising",
+	"upstairs",
+	"upstream",
+	"upstroke",
+	"upturned",
+	"urethane",
+	"urgently",
+	"usefully",
+	"utensils",
+	"vacation",
+	"vagabond",
+	"vagrancy",
+	"validate",
+	"validity",
+	"vampires",
+	"vanished",
+	"vanishes",
+	"vanquish",
+	"variably",
+	"variants",
+	"vascular",
+	"vaseline",
+	"vastness",
+	"vehicles",
+	"velocity",
+	"vendetta",
+	"vengeful",
+	"venomous",
+	"verbally",
+	"verbatim",
+	"verified",
+	"versions",
+	"veterans",
+	"vexingly",
+	"viewable",
+	"viewless",
+	"vigilant",
+	"vigorous",
+	"vilifies",
+	"villages",
+	"villains",
+	"vineyard",
+	"violated",
+	"violates",
+	"violator",
+	"viplates",
+	"virtuous",
+	"viselike",
+	"visiting",
+	"visitors",
+	"visually",
+	"vitality",
+	"vitalize",
+	"vitamins",
+	"vocalist",
+	"vocalize",
+	"vocation",
+	"volcanic",
+	"vomiting",
+	"vouchers",
+	"vultures",
+	"waltzing",
+	"wandered",
+	"wannabes",
+	"warnings",
+	"warrants",
+	"washable",
+	"washbowl",
+	"washroom",
+	"watchdog",
+	"watchers",
+	"watching",
+	"waterbed",
+	"watering",
+	"waviness",
+	"websites",
+	"weddings",
+	"weekends",
+	"weighing",
+	"weirdest",
+	"welcomed",
+	"welcomes",
+	"wellness",
+	"werewolf",
+	"whacking",
+	"wheeling",
+	"whipping",
+	"whiskers",
+	"whisking",
+	"whistles",
+	"whomever",
+	"whooping",
+	"wielding",
+	"wildcard",
+	"wildfire",
+	"wildfowl",
+	"wildland",
+	"wildness",
+	"windmill",
+	"winnings",
+	"wisplike",
+	"withheld",
+	"wobbling",
+	"wondered",
+	"woodwork",
+	"workable",
+	"workings",
+	"workload",
+	"worrying",
+	"wracking",
+	"wrangler",
+	"wrappers",
+	"wrapping",
+	"wreaking",
+	"wreckage",
+	"wrecking",
+	"wringing",
+	"wrinkled",
+	"wrinkles",
+	"wristlet",
+	"writings",
+	"wrongful",
+	"yachting",
+	"yearbook",
+	"yearling",
+	"yearning",
+	"yielding",
+	"youngest",
+	"zeppelin",
+	"zucchini",
+}